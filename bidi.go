@@ -0,0 +1,77 @@
+package cron
+
+import (
+	"strings"
+	"unicode"
+)
+
+const (
+	leftToRightIsolate    = '\u2066' // LRI
+	popDirectionalIsolate = '\u2069' // PDI
+)
+
+var (
+	// rtlLanguages are the languages written from right to left.
+	rtlLanguages = map[string]bool{
+		"ar": true, "ckb": true, "dv": true, "fa": true, "he": true, "iw": true,
+		"ps": true, "sd": true, "ug": true, "ur": true, "yi": true,
+	}
+	// rtlScripts are the scripts written from right to left.
+	rtlScripts = map[string]bool{
+		"Adlm": true, "Arab": true, "Hebr": true, "Nkoo": true, "Rohg": true, "Syrc": true, "Thaa": true,
+	}
+	// rtlRanges are the ranges of the letters written from right to left.
+	rtlRanges = []*unicode.RangeTable{unicode.Arabic, unicode.Hebrew, unicode.Nko, unicode.Syriac, unicode.Thaana}
+)
+
+// IsRightToLeft reports whether the locale is written from right to left, i.e. Hebrew or Persian.
+// The script of the locale type decides if it has one, i.e. "pa_Arab" is right to left but "pa" is not.
+func IsRightToLeft(typ LocaleType) bool {
+	tag, ok := parseLanguageTag(string(typ))
+	if !ok {
+		return false
+	}
+	if tag.script != "" {
+		return rtlScripts[tag.script]
+	}
+	return rtlLanguages[tag.language]
+}
+
+// isolateLeftToRight wraps the left-to-right fragments of the description (i.e. "10:00 AM" or "2020")
+// in Unicode directional isolates, so they're displayed in order in a right-to-left description.
+// A fragment starts and ends with a left-to-right letter or a digit, and may have spaces and the separators
+// of times and dates in between.
+func isolateLeftToRight(desc string) string {
+	runes := []rune(desc)
+	var b strings.Builder
+	for i := 0; i < len(runes); {
+		if !isLeftToRight(runes[i]) {
+			b.WriteRune(runes[i])
+			i++
+			continue
+		}
+
+		end := i + 1
+		for j := i + 1; j < len(runes); j++ {
+			if isLeftToRight(runes[j]) {
+				end = j + 1
+				continue
+			}
+			if !strings.ContainsRune(" :-/.+", runes[j]) {
+				break
+			}
+		}
+		b.WriteRune(leftToRightIsolate)
+		b.WriteString(string(runes[i:end]))
+		b.WriteRune(popDirectionalIsolate)
+		i = end
+	}
+	return b.String()
+}
+
+func isLeftToRight(r rune) bool {
+	if unicode.IsDigit(r) {
+		return true
+	}
+	return unicode.IsLetter(r) && !unicode.In(r, rtlRanges...)
+}
@@ -0,0 +1,77 @@
+package cron
+
+import (
+	"testing"
+)
+
+func TestIsRightToLeft(t *testing.T) {
+	tcs := []struct {
+		in  LocaleType
+		out bool
+	}{
+		{in: Locale_he, out: true},
+		{in: Locale_fa, out: true},
+		{in: "ar_EG", out: true},
+		{in: "pa_Arab", out: true},
+		{in: "pa", out: false},
+		{in: "az_Latn", out: false},
+		{in: Locale_en, out: false},
+		{in: Locale_zh_TW, out: false},
+		{in: "1x", out: false},
+	}
+	for i, tc := range tcs {
+		if got := IsRightToLeft(tc.in); got != tc.out {
+			t.Errorf("%d. %s: expected '%v', got '%v'", i, tc.in, tc.out, got)
+		}
+	}
+}
+
+func TestIsolateLeftToRight(t *testing.T) {
+	tcs := []struct {
+		in  string
+		out string
+	}{
+		{in: "ב 10:00 AM", out: "ב \u206610:00 AM\u2069"},
+		{in: "15:00 עד 15:59", out: "\u206615:00\u2069 עד \u206615:59\u2069"},
+		{in: ", 2020 עד 2025", out: ", \u20662020\u2069 עד \u20662025\u2069"},
+		{in: "در ۰۹:۳۰ AM, فقط در ۲۰۲۴", out: "در \u2066۰۹:۳۰ AM\u2069, فقط در \u2066۲۰۲۴\u2069"},
+		{in: "כל דקה", out: "כל דקה"},
+		{in: "", out: ""},
+	}
+	for i, tc := range tcs {
+		if got := isolateLeftToRight(tc.in); got != tc.out {
+			t.Errorf("%d. %q: expected %q, got %q", i, tc.in, tc.out, got)
+		}
+	}
+}
+
+func TestExpressionDescriptor_ToDescription_BidiIsolates(t *testing.T) {
+	type testCase struct {
+		inLocale  LocaleType
+		inIsolate bool
+		inExpr    string
+		out       string
+	}
+
+	tcs := []testCase{
+		{inLocale: Locale_he, inIsolate: true, inExpr: "0 10 * * *", out: "ב \u206610:00 AM\u2069"},
+		{inLocale: Locale_fa, inIsolate: true, inExpr: "30 9 * * *", out: "در \u2066۰۹:۳۰ AM\u2069"},
+		{inLocale: Locale_he, inIsolate: false, inExpr: "0 10 * * *", out: "ב 10:00 AM"},
+		{inLocale: Locale_en, inIsolate: true, inExpr: "0 10 * * *", out: "At 10:00 AM"},
+	}
+
+	for i, tc := range tcs {
+		exprDesc, err := NewDescriptor(SetLocales(Locale_he, Locale_fa), UseBidiIsolates(tc.inIsolate))
+		if err != nil {
+			t.Fatalf("failed to create expression descriptor: %s", err)
+		}
+		desc, err := exprDesc.ToDescription(tc.inExpr, tc.inLocale)
+		if err != nil {
+			t.Errorf("%d. %s: expected nil, got error '%v'", i, tc.inExpr, err)
+			continue
+		}
+		if desc != tc.out {
+			t.Errorf("%d. %s: expected %q, got %q", i, tc.inExpr, tc.out, desc)
+		}
+	}
+}
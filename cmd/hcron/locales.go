@@ -0,0 +1,83 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/lnquy/cron"
+)
+
+const localesUsage = `Usage:
+  hcron locales check [locale or locale file...]
+
+Checks the locales (all the built-in locales if none is given) or the JSON and YAML locale files
+for missing and unknown keys, values of the wrong type, strings which have not as many placeholders
+as in English, and days of the week and months of the year of the wrong length.
+Exits with status 1 if any issue is found.
+
+Examples:
+  $ hcron locales check
+  $ hcron locales check fr pt_BR
+  $ hcron locales check ./i18n/pt_PT.yaml
+`
+
+// runLocales runs the locales subcommand and returns the exit status.
+func runLocales(args []string) int {
+	if len(args) == 0 || args[0] == "-h" || args[0] == "--help" {
+		_, _ = fmt.Fprint(os.Stderr, localesUsage)
+		if len(args) == 0 {
+			return 2
+		}
+		return 0
+	}
+	if args[0] != "check" {
+		_, _ = fmt.Fprintf(os.Stderr, "unknown locales command '%s'\n\n%s", args[0], localesUsage)
+		return 2
+	}
+
+	var types []cron.LocaleType
+	var files []string
+	for _, arg := range args[1:] {
+		switch strings.ToLower(filepath.Ext(arg)) {
+		case ".json", ".yaml", ".yml":
+			files = append(files, arg)
+		default:
+			typ, err := cron.ParseLocale(arg)
+			if err != nil {
+				_, _ = fmt.Fprintf(os.Stderr, "failed to get locale: %s\n", err)
+				return 2
+			}
+			types = append(types, typ)
+		}
+	}
+
+	var issues []cron.LocaleIssue
+	if len(types) > 0 || len(files) == 0 {
+		got, err := cron.CheckLocales(types...)
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "failed to check locales: %s\n", err)
+			return 2
+		}
+		issues = append(issues, got...)
+	}
+	for _, file := range files {
+		got, err := cron.CheckLocaleFile(file)
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "failed to check locale file: %s\n", err)
+			return 2
+		}
+		issues = append(issues, got...)
+	}
+
+	for _, issue := range issues {
+		fmt.Println(issue)
+	}
+	if len(issues) > 0 {
+		_, _ = fmt.Fprintf(os.Stderr, "%d locale issue(s) found\n", len(issues))
+		return 1
+	}
+	fmt.Println("no locale issues found")
+	return 0
+}
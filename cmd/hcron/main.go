@@ -1,49 +1,81 @@
 package main
 
 import (
-	"bufio"
 	"flag"
 	"fmt"
 	"io"
 	"os"
-	"regexp"
+	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/lnquy/cron"
+	"github.com/lnquy/cron/crontab"
 )
 
 var (
 	version string // Will be injected at build time
 
 	fLocale               string
+	fLocaleFile           string
+	fDialect              string
+	fLayout               string
+	fTimeZone             string
+	fViewerTimeZone       string
 	fInputFilePath        string
 	fDayOfWeekStartsAtOne bool
 	fUse24HourTimeFormat  bool
+	fTimeFormat           string
 	fVerbose              bool
+	fStrict               bool
+	fHashSeed             string
+	fSymbolicHash         bool
+	fNoBidiIsolates       bool
 	fPrintAll             bool
+	fSystemCrontab        bool
+	fOutput               string
 	fVersion              bool
 	fHelp                 bool
-
-	acceptedCharsRegex = regexp.MustCompile(`^[wWlL /?,*#\-0-9]*$`)
 )
 
 func init() {
-	flag.StringVar(&fLocale, "locale", "en", "Output description in which locale")
+	flag.StringVar(&fLocale, "locale", "en", "Output description in which locale, i.e. fr or a BCP 47 language tag like de-CH")
+	flag.StringVar(&fLocaleFile, "locale-file", "", "Output description in the locale of a JSON or YAML file, i.e. ./pt_BR.yaml")
+	flag.StringVar(&fDialect, "dialect", "default", "Dialect of the CRON expressions: default, POSIX, Vixie, Quartz, Spring or EventBridge")
+	flag.StringVar(&fLayout, "layout", "auto", "Layout of the CRON expression fields: auto, 5-field, seconds-first, year-last or 7-field")
+	flag.StringVar(&fTimeZone, "tz", "", "Time zone of the CRON expressions without CRON_TZ= or TZ= prefix, i.e. Asia/Tokyo")
+	flag.StringVar(&fViewerTimeZone, "viewer-tz", "", "Also output the times converted into this time zone, i.e. Europe/London or Local")
 	flag.StringVar(&fInputFilePath, "file", "", "Path to crontab file")
 	flag.BoolVar(&fDayOfWeekStartsAtOne, "dow-starts-at-one", false, "Is day of the week starts at 1 (Monday-Sunday: 1-7)")
 	flag.BoolVar(&fUse24HourTimeFormat, "24-hour", false, "Output description in 24 hour time format")
+	flag.StringVar(&fTimeFormat, "time-format", "", "Output times in a Go layout or pattern, i.e. \"3:04 PM\" or \"N|h[:mm] a\" (overrides -24-hour)")
 	flag.BoolVar(&fVerbose, "verbose", false, "Output description in verbose format")
+	flag.BoolVar(&fStrict, "strict", false, "Reject the expressions which can never fire (i.e. February 30th)")
+	flag.StringVar(&fHashSeed, "hash-seed", "", "Seed to resolve the Jenkins hashed values (H) with, i.e. the job name")
+	flag.BoolVar(&fSymbolicHash, "symbolic-hash", false, "Describe the Jenkins hashed values (H) symbolically instead of with the resolved values")
+	flag.BoolVar(&fNoBidiIsolates, "no-bidi-isolates", false, "Don't wrap the left-to-right times and numbers in Unicode directional isolates for the right-to-left locales (he, fa)")
+	flag.StringVar(&fOutput, "o", "text", "Output format: text, json, ndjson, yaml or csv")
+	flag.BoolVar(&fSystemCrontab, "system-crontab", false, "Read the user column of the system crontabs (on for /etc/crontab and /etc/cron.d)")
 	flag.BoolVar(&fPrintAll, "print-all", false, "Also print all the lines which is not a valid cron")
 	flag.BoolVar(&fVersion, "v", false, "Print app version then exit")
 	flag.BoolVar(&fHelp, "h", false, "Print help then exit")
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "locales" {
+		os.Exit(runLocales(os.Args[2:]))
+	}
+	if len(os.Args) > 1 && os.Args[1] == "next" {
+		os.Exit(runNext(os.Args[2:], os.Stdout, os.Stderr))
+	}
+
 	flag.Usage = func() {
 		_, _ = fmt.Fprint(os.Stderr, `hcron converts the CRON expression to human readable description.
 
 Usage:
   hcron [flags] [cron expression]
+  hcron next [flags] [cron expression]
+  hcron locales check [locale or locale file...]
 
 Flags:
 `)
@@ -52,8 +84,17 @@ Flags:
 Examples:
   $ hcron "0 15 * * 1-5"
   $ hcron "0 */10 9 * * 1-5 2020"
+  $ hcron "@every 1h30m"
+  $ hcron -time-format "N|h[:mm] a" "0 9,12 * * *"
+  $ hcron -hash-seed my-job "H H(0-7) * * *"
+  $ hcron -dialect eventbridge "cron(0 12 ? * MON-FRI *)"
+  $ hcron -viewer-tz Europe/London "CRON_TZ=Asia/Tokyo 0 9 * * *"
   $ hcron -locale fr "0 */10 9 * * 1-5 2020"
+  $ hcron -locale-file ./fr.yaml "0 */10 9 * * 1-5 2020"
   $ hcron -file /var/spool/cron/crontabs/mycronfile
+  $ hcron -o json -file /var/spool/cron/crontabs/mycronfile
+  $ hcron next -n 10 -from 2026-01-01T00:00:00Z -tz Europe/Berlin "0 9 * * MON-FRI"
+  $ hcron locales check ./i18n/pt_PT.yaml
   $ another-app | hcron 
   $ another-app | hcron --dow-starts-at-one --24-hour -locale es`)
 	}
@@ -71,128 +112,190 @@ Examples:
 		return
 	}
 
-	exprDesc, locale, err := getExpressionDescriptor()
+	opts, locale, err := getOptions()
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to init expression descriptor: %s\n", err)
+		os.Exit(1)
+	}
+	exprDesc, err := cron.NewDescriptor(opts...)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to init expression descriptor: %s\n", err)
+		os.Exit(1)
+	}
+	parser := cron.NewParser(opts...)
+
+	out, err := newRecordWriter(fOutput, os.Stdout)
 	if err != nil {
-		fmt.Printf("failed to init expression descriptor: %s", err)
+		_, _ = fmt.Fprintf(os.Stderr, "failed to init output: %s\n", err)
 		os.Exit(1)
 	}
 
 	// Read from stdin
 	fi, err := os.Stdin.Stat()
 	if err != nil {
-		fmt.Printf("failed to get stdin info: %s", err)
+		_, _ = fmt.Fprintf(os.Stderr, "failed to get stdin info: %s\n", err)
 		os.Exit(1)
 	}
 	isPiped := (fi.Mode() & os.ModeCharDevice) == 0
 
-	// Run in piped mode, read from the stdin until reaching EOF
-	if isPiped {
-		if err := stream(exprDesc, locale, bufio.NewReader(os.Stdin)); err != nil {
-			fmt.Printf("error: %s", err)
-			os.Exit(1)
-		}
-		return
-	}
-
-	// Run in standalone mode
-	// Read from crontab input file
-	if strings.TrimSpace(fInputFilePath) != "" {
-		f, err := os.OpenFile(fInputFilePath, os.O_RDONLY, os.ModePerm)
-		if err != nil {
-			fmt.Printf("failed to open file: %s", err)
+	var failed int
+	switch {
+	case isPiped: // Run in piped mode, read from the stdin until reaching EOF
+		failed, err = stream(exprDesc, parser, locale, os.Stdin, out)
+	case strings.TrimSpace(fInputFilePath) != "": // Run in standalone mode, read from crontab input file
+		f, openErr := os.OpenFile(fInputFilePath, os.O_RDONLY, os.ModePerm)
+		if openErr != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "failed to open file: %s\n", openErr)
 			os.Exit(1)
 		}
-		if err := stream(exprDesc, locale, bufio.NewReader(f)); err != nil {
-			fmt.Printf("error: %s", err)
-			os.Exit(1)
+		failed, err = stream(exprDesc, parser, locale, f, out)
+		_ = f.Close()
+	case len(os.Args) <= 1:
+		_, _ = fmt.Fprintln(os.Stderr, "cron expression must be specified")
+		os.Exit(1)
+	default: // Get description for the last cmd parameter
+		expr := os.Args[len(os.Args)-1]
+		rec := describe(exprDesc, parser, locale, &record{Line: expr, Expression: expr})
+		if rec.Error != nil {
+			failed++
+			_, _ = fmt.Fprintf(os.Stderr, "invalid cron expression '%s': %s\n", expr, rec.Error.Message)
 		}
-		return
+		err = out.Write(rec)
 	}
-
-	if len(os.Args) <= 1 {
-		fmt.Println("cron expression must be specified")
-		os.Exit(1)
+	if err == nil {
+		err = out.Close()
 	}
-
-	// Get description for the last cmd parameter
-	expr := os.Args[len(os.Args)-1]
-	desc, err := exprDesc.ToDescription(expr, locale)
 	if err != nil {
-		fmt.Printf("invalid cron expression '%s': %s", expr, err)
+		_, _ = fmt.Fprintf(os.Stderr, "error: %s\n", err)
+		os.Exit(1)
+	}
+	if failed > 0 {
 		os.Exit(1)
 	}
-	fmt.Printf("%s: %s\n", expr, desc)
 }
 
 func getExpressionDescriptor() (exprDesc *cron.ExpressionDescriptor, locType cron.LocaleType, err error) {
-	opts := []cron.Option{
+	opts, locType, err := getOptions()
+	if err != nil {
+		return nil, "", err
+	}
+	exprDesc, err = cron.NewDescriptor(opts...)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to init cron expression descriptor: %s", err)
+	}
+	return exprDesc, locType, nil
+}
+
+// getOptions returns the options of the expression descriptor and the locale of the flags.
+func getOptions() (opts []cron.Option, locType cron.LocaleType, err error) {
+	opts = []cron.Option{
 		cron.Verbose(fVerbose),
 		cron.Use24HourTimeFormat(fUse24HourTimeFormat),
 		cron.DayOfWeekStartsAtOne(fDayOfWeekStartsAtOne),
+		cron.StrictValidation(fStrict),
+		cron.SymbolicHash(fSymbolicHash),
+		cron.TimeFormat(fTimeFormat),
+	}
+	if fHashSeed != "" || fSymbolicHash {
+		opts = append(opts, cron.HashSeed(fHashSeed))
 	}
 
-	loc, err := cron.ParseLocale(fLocale)
+	dialect, err := cron.ParseDialect(fDialect)
 	if err != nil {
-		return nil, "", fmt.Errorf("failed to get locale: %w", err)
+		return nil, "", fmt.Errorf("failed to get dialect: %w", err)
 	}
-	opts = append(opts, cron.SetLocales(loc))
+	opts = append(opts, cron.SetDialect(dialect))
 
-	exprDesc, err = cron.NewDescriptor(opts...)
+	layout, err := cron.ParseFieldLayout(fLayout)
 	if err != nil {
-		return nil, "", fmt.Errorf("failed to init cron expression descriptor: %s", err)
+		return nil, "", fmt.Errorf("failed to get field layout: %w", err)
 	}
-	return exprDesc, loc, nil
-}
+	opts = append(opts, cron.SetFieldLayout(layout))
 
-func stream(exprDesc *cron.ExpressionDescriptor, locType cron.LocaleType, reader *bufio.Reader) error {
-	for {
-		line, _, err := reader.ReadLine()
-		if err != nil && err == io.EOF { // Source stream closed
-			return nil
+	if fTimeZone != "" {
+		tz, err := time.LoadLocation(fTimeZone)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to get time zone: %w", err)
 		}
-
-		expr, remaining := normalize(string(line))
-		if expr == "" { // Not a parse-able CRON expression
-			continue
+		opts = append(opts, cron.WithLocation(tz))
+	}
+	if fViewerTimeZone != "" {
+		tz, err := time.LoadLocation(fViewerTimeZone)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to get viewer time zone: %w", err)
 		}
+		opts = append(opts, cron.WithViewerLocation(tz))
+	}
 
-		// fmt.Printf("expr: %s, remaining: %s, line: %s", expr, remaining, line)
-		desc, err := exprDesc.ToDescription(expr, locType)
+	var loc cron.LocaleType
+	if fLocaleFile != "" {
+		locale, err := cron.LoadLocaleFromFile(fLocaleFile)
 		if err != nil {
-			fmt.Printf("error: %s\n", err)
-			continue
+			return nil, "", fmt.Errorf("failed to load locale file: %w", err)
 		}
-
-		if remaining != "" {
-			fmt.Printf("%s: %s | %s\n", expr, desc, remaining)
-			continue
+		loc = locale.GetLocaleType()
+		opts = append(opts, cron.SetLocaleLoaders(locale))
+	} else {
+		if loc, err = cron.ParseLocale(fLocale); err != nil {
+			return nil, "", fmt.Errorf("failed to get locale: %w", err)
 		}
-		fmt.Printf("%s: %s\n", expr, desc)
+		opts = append(opts, cron.SetLocales(loc))
 	}
+	// The left-to-right times and numbers are scrambled in the right-to-left descriptions without isolates
+	opts = append(opts, cron.UseBidiIsolates(!fNoBidiIsolates && cron.IsRightToLeft(loc)))
+
+	return opts, loc, nil
 }
 
-func normalize(line string) (expr string, remainder string) {
-	if strings.HasPrefix(line, "#") {
-		if fPrintAll {
-			fmt.Printf("%s\n", line)
+// stream writes the records of the scheduled commands of the crontab and returns the number of the lines which failed.
+// The errors of the lines are reported on stderr.
+func stream(exprDesc *cron.ExpressionDescriptor, parser cron.Parser, locType cron.LocaleType, reader io.Reader,
+	out recordWriter) (failed int, err error) {
+	scanErr := scanCrontab(reader, func(entry crontab.Entry, expr string) {
+		if err != nil {
+			return
+		}
+		rec := &record{Line: entry.Line, LineNumber: entry.LineNumber, Expression: expr, User: entry.User, Command: entry.Command,
+			Input: entry.Input}
+		switch entry.Type {
+		case crontab.EntrySchedule:
+			rec = describe(exprDesc, parser, locType, rec)
+		case crontab.EntryInvalid:
+			rec.Error = &recordError{Message: entry.Err.Error()}
 		}
-		return "", line
+		if rec.Error != nil {
+			failed++
+			_, _ = fmt.Fprintf(os.Stderr, "line %d: error: %s\n", entry.LineNumber, rec.Error.Message)
+		}
+		err = out.Write(rec)
+	})
+	if err == nil {
+		err = scanErr
 	}
+	return failed, err
+}
 
-	parts := strings.Fields(line)
-	if len(parts) < 5 {
-		if fPrintAll {
-			fmt.Printf("%s\n", line)
+// scanCrontab reads the crontab until reaching EOF and calls fn with each scheduled command and its CRON expression,
+// prefixed with the CRON_TZ= of the time zone it's scheduled in, and with each invalid line (see crontab.Entry.Err).
+// The other lines (i.e. comments) are passed with an empty expression with -print-all.
+func scanCrontab(reader io.Reader, fn func(entry crontab.Entry, expr string)) error {
+	isSystemCrontab := fSystemCrontab || filepath.Clean(fInputFilePath) == "/etc/crontab" ||
+		filepath.Dir(filepath.Clean(fInputFilePath)) == "/etc/cron.d"
+	scanner := crontab.NewScanner(reader, crontab.BareExpressions(true), crontab.SystemCrontab(isSystemCrontab))
+	for scanner.Scan() {
+		entry := scanner.Entry()
+		if entry.Type != crontab.EntrySchedule {
+			if entry.Type == crontab.EntryInvalid || fPrintAll {
+				fn(entry, "")
+			}
+			continue
 		}
-		return "", line
-	}
 
-	// Line contains invalid chars => Assume it's in crontab format
-	// First 5 parts is the CRON expression, the remaining is user and commands
-	if !acceptedCharsRegex.MatchString(line) {
-		return strings.Join(parts[:5], " "), strings.Join(parts[5:], " ")
+		expr := entry.Expression
+		if entry.TimeZone != "" {
+			expr = "CRON_TZ=" + entry.TimeZone + " " + expr
+		}
+		fn(entry, expr)
 	}
-
-	// Only contains accepted CRON characters => Assume valid CRON expression
-	return line, ""
+	return scanner.Err()
 }
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/lnquy/cron"
+)
+
+func TestStream(t *testing.T) {
+	tcs := []struct {
+		inCrontab  string
+		inPrintAll bool
+		outErrors  []string // Error message of each record, empty if the record has no error
+		outFailed  int
+	}{
+		{inCrontab: "0 9 * * *\n", outErrors: []string{""}, outFailed: 0},
+		{inCrontab: "# comment\n\nMAILTO=root\n0 9 * * * /bin/x\n", outErrors: []string{""}, outFailed: 0},
+		{inCrontab: "0 9 * *\n0 9 * * *\n", outErrors: []string{"schedule has 4 fields, 5 fields required", ""}, outFailed: 1},
+		{inCrontab: "Backup done\nhello world\n0 9 * * *\n", outErrors: []string{""}, outFailed: 0},
+		{inCrontab: "0 25 * * * /bin/x\n", outErrors: []string{"failed to parse CRON expression"}, outFailed: 1},
+		{inCrontab: "# comment\nBackup done\n0 9 * *\n0 9 * * *\n", inPrintAll: true, outErrors: []string{"", "", "schedule has 4 fields, 5 fields required", ""}, outFailed: 1},
+	}
+
+	exprDesc, err := cron.NewDescriptor()
+	if err != nil {
+		t.Fatalf("failed to create expression descriptor: %s", err)
+	}
+	defer func() { fPrintAll = false }()
+	for i, tc := range tcs {
+		fPrintAll = tc.inPrintAll
+		var buf bytes.Buffer
+		out, err := newRecordWriter("ndjson", &buf)
+		if err != nil {
+			t.Fatalf("failed to create record writer: %s", err)
+		}
+		failed, err := stream(exprDesc, cron.NewParser(), cron.Locale_en, strings.NewReader(tc.inCrontab), out)
+		if err != nil {
+			t.Errorf("%d. %q: expected nil, got error '%v'", i, tc.inCrontab, err)
+			continue
+		}
+		if failed != tc.outFailed {
+			t.Errorf("%d. %q: expected %d failed lines, got %d", i, tc.inCrontab, tc.outFailed, failed)
+		}
+
+		var errs []string
+		dec := json.NewDecoder(&buf)
+		for dec.More() {
+			var rec record
+			if err := dec.Decode(&rec); err != nil {
+				t.Fatalf("%d. %q: failed to decode record: %s", i, tc.inCrontab, err)
+			}
+			if rec.Error != nil {
+				errs = append(errs, rec.Error.Message)
+			} else {
+				errs = append(errs, "")
+			}
+		}
+		if len(errs) != len(tc.outErrors) {
+			t.Errorf("%d. %q: expected %d records, got %d", i, tc.inCrontab, len(tc.outErrors), len(errs))
+			continue
+		}
+		for j := range errs {
+			if (errs[j] == "") != (tc.outErrors[j] == "") || !strings.HasPrefix(errs[j], tc.outErrors[j]) {
+				t.Errorf("%d. %q: expected record %d error '%s', got '%s'", i, tc.inCrontab, j, tc.outErrors[j], errs[j])
+			}
+		}
+	}
+}
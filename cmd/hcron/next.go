@@ -0,0 +1,185 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/lnquy/cron"
+	"github.com/lnquy/cron/crontab"
+)
+
+const nextUsage = `Usage:
+  hcron next [flags] [cron expression]
+
+Prints the upcoming run times of the CRON expression, or of each line of the crontab file or stdin,
+one per line.
+
+Flags:
+`
+
+const nextExamples = `
+Examples:
+  $ hcron next "0 9 * * MON-FRI"
+  $ hcron next -n 10 -from 2026-01-01T00:00:00Z -tz Europe/Berlin "0 9 * * MON-FRI"
+  $ hcron next -format "Mon 02 Jan 15:04 MST" -file /var/spool/cron/crontabs/mycronfile
+  $ crontab -l | hcron next -n 3
+`
+
+// runNext runs the next subcommand and returns the exit status.
+// The run times are printed on stdout and the errors on stderr.
+func runNext(args []string, stdout, stderr io.Writer) int {
+	var (
+		n      int
+		from   string
+		format string
+	)
+	fs := flag.NewFlagSet("next", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.IntVar(&n, "n", 5, "Number of run times to print for each expression")
+	fs.StringVar(&from, "from", "", "Print the run times after this RFC 3339 time, i.e. 2026-01-01T00:00:00Z (default now)")
+	fs.StringVar(&format, "format", time.RFC3339, "Go layout of the printed run times, i.e. \"Mon 02 Jan 15:04 MST\"")
+	fs.StringVar(&fTimeZone, "tz", "", "Time zone of the CRON expressions without CRON_TZ= or TZ= prefix, i.e. Asia/Tokyo")
+	fs.StringVar(&fDialect, "dialect", "default", "Dialect of the CRON expressions: default, POSIX, Vixie, Quartz, Spring or EventBridge")
+	fs.StringVar(&fLayout, "layout", "auto", "Layout of the CRON expression fields: auto, 5-field, seconds-first, year-last or 7-field")
+	fs.StringVar(&fInputFilePath, "file", "", "Path to crontab file")
+	fs.BoolVar(&fDayOfWeekStartsAtOne, "dow-starts-at-one", false, "Is day of the week starts at 1 (Monday-Sunday: 1-7)")
+	fs.StringVar(&fHashSeed, "hash-seed", "", "Seed to resolve the Jenkins hashed values (H) with, i.e. the job name")
+	fs.Usage = func() {
+		_, _ = fmt.Fprint(stderr, nextUsage)
+		fs.PrintDefaults()
+		_, _ = fmt.Fprint(stderr, nextExamples)
+	}
+	if err := fs.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return 0
+		}
+		return 2
+	}
+	if n < 1 {
+		_, _ = fmt.Fprintf(stderr, "-n must be positive, got %d\n", n)
+		return 2
+	}
+
+	start := time.Now()
+	if from != "" {
+		var err error
+		if start, err = time.Parse(time.RFC3339, from); err != nil {
+			_, _ = fmt.Fprintf(stderr, "failed to parse -from time: %s\n", err)
+			return 2
+		}
+	}
+
+	exprDesc, _, err := getExpressionDescriptor()
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "failed to init expression descriptor: %s\n", err)
+		return 2
+	}
+	printNext := func(expr string) error {
+		return printRunTimes(stdout, exprDesc, expr, start, n, format)
+	}
+
+	// Read the crontab from the input file or stdin, the run times of each line follow it
+	var reader io.Reader
+	if strings.TrimSpace(fInputFilePath) != "" {
+		f, err := os.Open(fInputFilePath)
+		if err != nil {
+			_, _ = fmt.Fprintf(stderr, "failed to open file: %s\n", err)
+			return 1
+		}
+		defer f.Close()
+		reader = f
+	} else if fi, err := os.Stdin.Stat(); err == nil && (fi.Mode()&os.ModeCharDevice) == 0 && fs.NArg() == 0 {
+		reader = os.Stdin
+	}
+	if reader != nil {
+		first, failed := true, false
+		err := scanCrontab(reader, func(entry crontab.Entry, expr string) {
+			if entry.Type == crontab.EntryInvalid {
+				failed = true
+				_, _ = fmt.Fprintf(stderr, "line %d: error: %s\n", entry.LineNumber, entry.Err)
+				return
+			}
+			if entry.Type != crontab.EntrySchedule {
+				_, _ = fmt.Fprintf(stdout, "%s\n", entry.Line) // -print-all
+				return
+			}
+			if !first {
+				_, _ = fmt.Fprintln(stdout)
+			}
+			first = false
+			if remaining := strings.TrimSpace(entry.User + " " + entry.Command); remaining != "" {
+				_, _ = fmt.Fprintf(stdout, "%s | %s\n", expr, remaining)
+			} else {
+				_, _ = fmt.Fprintf(stdout, "%s\n", expr)
+			}
+			if err := printNext(expr); err != nil {
+				failed = true
+				_, _ = fmt.Fprintf(stderr, "line %d: error: %s\n", entry.LineNumber, err)
+			}
+		})
+		if err != nil {
+			_, _ = fmt.Fprintf(stderr, "error: %s\n", err)
+			return 1
+		}
+		if failed {
+			return 1
+		}
+		return 0
+	}
+
+	if fs.NArg() == 0 {
+		_, _ = fmt.Fprintln(stderr, "cron expression must be specified")
+		fs.Usage()
+		return 2
+	}
+	expr := fs.Arg(fs.NArg() - 1)
+	if err := printNext(expr); err != nil {
+		_, _ = fmt.Fprintf(stderr, "invalid cron expression '%s': %s\n", expr, err)
+		return 1
+	}
+	return 0
+}
+
+// printRunTimes prints the next n run times of the CRON expression after start, in the time zone of the expression
+// if it has one. The @every and rate() expressions run every interval from start, and @reboot has no run times
+// so a note is printed instead.
+func printRunTimes(w io.Writer, exprDesc *cron.ExpressionDescriptor, expr string, start time.Time, n int, format string) error {
+	schedule, err := exprDesc.ToSchedule(expr)
+	if err != nil {
+		every, intervalErr := exprDesc.ToInterval(expr)
+		if intervalErr != nil {
+			return err
+		}
+		if every == 0 {
+			_, err = fmt.Fprintln(w, "no scheduled run times (at system startup)")
+			return err
+		}
+		for i := 1; i <= n; i++ {
+			if _, err = fmt.Fprintln(w, start.Add(time.Duration(i)*every).Format(format)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if schedule.Location != nil {
+		start = start.In(schedule.Location)
+	}
+
+	t := start
+	for i := 0; i < n; i++ {
+		if t = schedule.Next(t); t.IsZero() {
+			if i == 0 {
+				return fmt.Errorf("the expression never fires after %s", start.Format(time.RFC3339))
+			}
+			return nil
+		}
+		if _, err = fmt.Fprintln(w, t.Format(format)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
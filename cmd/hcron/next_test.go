@@ -0,0 +1,94 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRunNext(t *testing.T) {
+	dir, err := ioutil.TempDir("", "hcron-next")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	crontabPath := filepath.Join(dir, "crontab")
+	crontab := "0 9 * * MON-FRI /bin/x\n@reboot /bin/y\n"
+	if err := ioutil.WriteFile(crontabPath, []byte(crontab), 0600); err != nil {
+		t.Fatalf("failed to write crontab: %s", err)
+	}
+	everyCrontabPath := filepath.Join(dir, "every-crontab")
+	if err := ioutil.WriteFile(everyCrontabPath, []byte("@every 2h /bin/b\n"), 0600); err != nil {
+		t.Fatalf("failed to write crontab: %s", err)
+	}
+
+	tcs := []struct {
+		inArgs    []string
+		outStdout string
+		outStderr string
+		outStatus int
+	}{
+		{
+			inArgs:    []string{"-n", "2", "-from", "2026-01-02T10:00:00Z", "0 9 * * MON-FRI"},
+			outStdout: "2026-01-05T09:00:00Z\n2026-01-06T09:00:00Z\n",
+		},
+		{
+			inArgs:    []string{"-n", "1", "-from", "2026-01-02T10:00:00Z", "-file", crontabPath},
+			outStdout: "0 9 * * MON-FRI | /bin/x\n2026-01-05T09:00:00Z\n\n@reboot | /bin/y\nno scheduled run times (at system startup)\n",
+		},
+		{
+			inArgs:    []string{"@reboot"},
+			outStdout: "no scheduled run times (at system startup)\n",
+		},
+		{
+			inArgs:    []string{"-n", "2", "-from", "2026-01-02T10:00:00Z", "@every 1h30m"},
+			outStdout: "2026-01-02T11:30:00Z\n2026-01-02T13:00:00Z\n",
+		},
+		{
+			inArgs:    []string{"-n", "2", "-from", "2026-01-02T10:00:00Z", "rate(5 minutes)"},
+			outStdout: "2026-01-02T10:05:00Z\n2026-01-02T10:10:00Z\n",
+		},
+		{
+			inArgs:    []string{"-n", "1", "-from", "2026-01-02T10:00:00Z", "-file", everyCrontabPath},
+			outStdout: "@every 2h | /bin/b\n2026-01-02T12:00:00Z\n",
+		},
+		{
+			inArgs:    []string{"@every 1x"},
+			outStderr: "invalid cron expression '@every 1x'",
+			outStatus: 1,
+		},
+		{
+			inArgs:    []string{"-dialect", "posix", "@reboot"},
+			outStderr: "invalid cron expression '@reboot'",
+			outStatus: 1,
+		},
+		{
+			inArgs:    []string{"0 0 30 2 *"},
+			outStderr: "invalid cron expression '0 0 30 2 *': the expression never fires",
+			outStatus: 1,
+		},
+		{
+			inArgs:    []string{"-n", "0", "* * * * *"},
+			outStderr: "-n must be positive, got 0",
+			outStatus: 2,
+		},
+	}
+
+	for i, tc := range tcs {
+		fInputFilePath, fDialect, fLayout, fTimeZone, fLocale = "", "default", "auto", "", "en"
+		var stdout, stderr bytes.Buffer
+		if status := runNext(tc.inArgs, &stdout, &stderr); status != tc.outStatus {
+			t.Errorf("%d. %v: expected exit status %d, got %d (%s)", i, tc.inArgs, tc.outStatus, status, stderr.String())
+		}
+		if stdout.String() != tc.outStdout {
+			t.Errorf("%d. %v: expected stdout '%s', got '%s'", i, tc.inArgs, tc.outStdout, stdout.String())
+		}
+		if !strings.HasPrefix(stderr.String(), tc.outStderr) || (tc.outStderr == "") != (stderr.Len() == 0) {
+			t.Errorf("%d. %v: expected stderr '%s', got '%s'", i, tc.inArgs, tc.outStderr, stderr.String())
+		}
+	}
+}
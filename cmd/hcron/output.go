@@ -0,0 +1,204 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/lnquy/cron"
+)
+
+type (
+	// record is the result of describing a CRON expression, of a line of the crontab or of the command line.
+	record struct {
+		Line        string       `json:"line"`
+		LineNumber  int          `json:"lineNumber,omitempty"` // 0 for the expression of the command line
+		Expression  string       `json:"expression"`           // Empty for the lines which are not scheduled commands, with -print-all
+		Fields      []string     `json:"fields,omitempty"`     // Normalized 7-part fields, empty for the macros without time fields
+		Description string       `json:"description,omitempty"`
+		User        string       `json:"user,omitempty"` // User of the command in the system crontabs
+		Command     string       `json:"command,omitempty"`
+		Input       string       `json:"input,omitempty"` // Text after the first % of the command, sent to it on stdin
+		Error       *recordError `json:"error,omitempty"`
+	}
+
+	// recordError is the error of an invalid CRON expression, with the invalid token if it's known.
+	recordError struct {
+		Message string `json:"message"`
+		Field   string `json:"field,omitempty"`
+		Token   string `json:"token,omitempty"`
+		Offset  *int   `json:"offset,omitempty"`
+	}
+
+	// recordWriter writes the records in an output format.
+	recordWriter interface {
+		Write(rec *record) error
+		Close() error
+	}
+
+	textWriter struct{ w io.Writer }
+	jsonWriter struct {
+		w       io.Writer
+		records []*record
+	}
+	ndjsonWriter struct{ enc *json.Encoder }
+	yamlWriter   struct{ w io.Writer }
+	csvWriter    struct{ w *csv.Writer }
+)
+
+// outputFormats are the formats of the -o flag.
+var outputFormats = []string{"text", "json", "ndjson", "yaml", "csv"}
+
+// newRecordWriter returns the writer of the output format.
+func newRecordWriter(format string, w io.Writer) (recordWriter, error) {
+	switch strings.ToLower(format) {
+	case "", "text":
+		return &textWriter{w: w}, nil
+	case "json":
+		return &jsonWriter{w: w, records: make([]*record, 0)}, nil
+	case "ndjson":
+		return &ndjsonWriter{enc: json.NewEncoder(w)}, nil
+	case "yaml":
+		return &yamlWriter{w: w}, nil
+	case "csv":
+		cw := csv.NewWriter(w)
+		header := []string{"line_number", "line", "expression", "second", "minute", "hour", "day_of_month", "month",
+			"day_of_week", "year", "description", "user", "command", "input", "error"}
+		if err := cw.Write(header); err != nil {
+			return nil, err
+		}
+		return &csvWriter{w: cw}, nil
+	}
+	return nil, fmt.Errorf("unknown output format '%s', supported formats are %s", format, strings.Join(outputFormats, ", "))
+}
+
+// describe fills the description and the normalized fields of the CRON expression of the record,
+// or its error if the expression is invalid.
+func describe(exprDesc *cron.ExpressionDescriptor, parser cron.Parser, locType cron.LocaleType, rec *record) *record {
+	desc, err := exprDesc.ToDescription(rec.Expression, locType)
+	if err != nil {
+		rec.Error = newRecordError(err)
+		return rec
+	}
+	rec.Description = desc
+	rec.Fields, _ = parser.Parse(rec.Expression) // The macros without time fields (i.e. @reboot) have no fields
+	return rec
+}
+
+func newRecordError(err error) *recordError {
+	recErr := &recordError{Message: err.Error()}
+	var parseErr *cron.ParseError
+	if errors.As(err, &parseErr) {
+		recErr.Field = parseErr.Field.String()
+		recErr.Token = parseErr.Token
+		recErr.Offset = &parseErr.Offset
+	}
+	return recErr
+}
+
+func (t *textWriter) Write(rec *record) error {
+	if rec.Error != nil {
+		return nil // Reported on stderr
+	}
+	if rec.Expression == "" {
+		_, err := fmt.Fprintf(t.w, "%s\n", rec.Line)
+		return err
+	}
+	if remaining := strings.TrimSpace(rec.User + " " + rec.Command); remaining != "" {
+		_, err := fmt.Fprintf(t.w, "%s: %s | %s\n", rec.Expression, rec.Description, remaining)
+		return err
+	}
+	_, err := fmt.Fprintf(t.w, "%s: %s\n", rec.Expression, rec.Description)
+	return err
+}
+
+func (t *textWriter) Close() error {
+	return nil
+}
+
+func (j *jsonWriter) Write(rec *record) error {
+	j.records = append(j.records, rec)
+	return nil
+}
+
+func (j *jsonWriter) Close() error {
+	data, err := json.MarshalIndent(j.records, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(j.w, "%s\n", data)
+	return err
+}
+
+func (n *ndjsonWriter) Write(rec *record) error {
+	return n.enc.Encode(rec)
+}
+
+func (n *ndjsonWriter) Close() error {
+	return nil
+}
+
+func (y *yamlWriter) Write(rec *record) error {
+	var b strings.Builder
+	b.WriteString("- line: " + strconv.Quote(rec.Line) + "\n")
+	if rec.LineNumber > 0 {
+		b.WriteString("  lineNumber: " + strconv.Itoa(rec.LineNumber) + "\n")
+	}
+	b.WriteString("  expression: " + strconv.Quote(rec.Expression) + "\n")
+	if len(rec.Fields) > 0 {
+		quoted := make([]string, len(rec.Fields))
+		for i, field := range rec.Fields {
+			quoted[i] = strconv.Quote(field)
+		}
+		b.WriteString("  fields: [" + strings.Join(quoted, ", ") + "]\n")
+	}
+	if rec.Description != "" {
+		b.WriteString("  description: " + strconv.Quote(rec.Description) + "\n")
+	}
+	if rec.User != "" {
+		b.WriteString("  user: " + strconv.Quote(rec.User) + "\n")
+	}
+	if rec.Command != "" {
+		b.WriteString("  command: " + strconv.Quote(rec.Command) + "\n")
+	}
+	if rec.Input != "" {
+		b.WriteString("  input: " + strconv.Quote(rec.Input) + "\n")
+	}
+	if rec.Error != nil {
+		b.WriteString("  error:\n    message: " + strconv.Quote(rec.Error.Message) + "\n")
+		if rec.Error.Field != "" {
+			b.WriteString("    field: " + strconv.Quote(rec.Error.Field) + "\n")
+			b.WriteString("    token: " + strconv.Quote(rec.Error.Token) + "\n")
+			b.WriteString("    offset: " + strconv.Itoa(*rec.Error.Offset) + "\n")
+		}
+	}
+	_, err := io.WriteString(y.w, b.String())
+	return err
+}
+
+func (y *yamlWriter) Close() error {
+	return nil
+}
+
+func (c *csvWriter) Write(rec *record) error {
+	lineNumber, errMsg := "", ""
+	if rec.LineNumber > 0 {
+		lineNumber = strconv.Itoa(rec.LineNumber)
+	}
+	if rec.Error != nil {
+		errMsg = rec.Error.Message
+	}
+	fields := make([]string, 7)
+	copy(fields, rec.Fields)
+	row := append([]string{lineNumber, rec.Line, rec.Expression}, fields...)
+	return c.w.Write(append(row, rec.Description, rec.User, rec.Command, rec.Input, errMsg))
+}
+
+func (c *csvWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/lnquy/cron"
+)
+
+func TestRecordWriter(t *testing.T) {
+	exprDesc, err := cron.NewDescriptor()
+	if err != nil {
+		t.Fatalf("failed to create expression descriptor: %s", err)
+	}
+	offset := 2
+	records := []*record{
+		describe(exprDesc, cron.NewParser(), cron.Locale_en, &record{Line: "0 9 * * 1-5 /bin/x %hello", LineNumber: 1,
+			Expression: "0 9 * * 1-5", Command: "/bin/x", Input: "hello"}),
+		{Line: "0 25 * * *", LineNumber: 2, Expression: "0 25 * * *",
+			Error: &recordError{Message: "hour contains invalid values", Field: "hour", Token: "25", Offset: &offset}},
+		{Line: "# backup", LineNumber: 3}, // -print-all
+	}
+
+	tcs := []struct {
+		inFormat string
+		out      string
+	}{
+		{
+			inFormat: "text",
+			out:      "0 9 * * 1-5: At 09:00 AM, Monday through Friday | /bin/x\n# backup\n",
+		},
+		{
+			inFormat: "ndjson",
+			out: `{"line":"0 9 * * 1-5 /bin/x %hello","lineNumber":1,"expression":"0 9 * * 1-5","fields":["","0","9","*","*","1-5",""],"description":"At 09:00 AM, Monday through Friday","command":"/bin/x","input":"hello"}
+{"line":"0 25 * * *","lineNumber":2,"expression":"0 25 * * *","error":{"message":"hour contains invalid values","field":"hour","token":"25","offset":2}}
+{"line":"# backup","lineNumber":3,"expression":""}
+`,
+		},
+		{
+			inFormat: "csv",
+			out: `line_number,line,expression,second,minute,hour,day_of_month,month,day_of_week,year,description,user,command,input,error
+1,0 9 * * 1-5 /bin/x %hello,0 9 * * 1-5,,0,9,*,*,1-5,,"At 09:00 AM, Monday through Friday",,/bin/x,hello,
+2,0 25 * * *,0 25 * * *,,,,,,,,,,,,hour contains invalid values
+3,# backup,,,,,,,,,,,,,
+`,
+		},
+		{
+			inFormat: "yaml",
+			out: `- line: "0 9 * * 1-5 /bin/x %hello"
+  lineNumber: 1
+  expression: "0 9 * * 1-5"
+  fields: ["", "0", "9", "*", "*", "1-5", ""]
+  description: "At 09:00 AM, Monday through Friday"
+  command: "/bin/x"
+  input: "hello"
+- line: "0 25 * * *"
+  lineNumber: 2
+  expression: "0 25 * * *"
+  error:
+    message: "hour contains invalid values"
+    field: "hour"
+    token: "25"
+    offset: 2
+- line: "# backup"
+  lineNumber: 3
+  expression: ""
+`,
+		},
+		{
+			inFormat: "json",
+			out: `[
+  {
+    "line": "0 9 * * 1-5 /bin/x %hello",
+    "lineNumber": 1,
+    "expression": "0 9 * * 1-5",
+    "fields": [
+      "",
+      "0",
+      "9",
+      "*",
+      "*",
+      "1-5",
+      ""
+    ],
+    "description": "At 09:00 AM, Monday through Friday",
+    "command": "/bin/x",
+    "input": "hello"
+  },
+  {
+    "line": "0 25 * * *",
+    "lineNumber": 2,
+    "expression": "0 25 * * *",
+    "error": {
+      "message": "hour contains invalid values",
+      "field": "hour",
+      "token": "25",
+      "offset": 2
+    }
+  },
+  {
+    "line": "# backup",
+    "lineNumber": 3,
+    "expression": ""
+  }
+]
+`,
+		},
+	}
+
+	for i, tc := range tcs {
+		var buf bytes.Buffer
+		out, err := newRecordWriter(tc.inFormat, &buf)
+		if err != nil {
+			t.Fatalf("%d. %s: failed to create record writer: %s", i, tc.inFormat, err)
+		}
+		for _, rec := range records {
+			if err := out.Write(rec); err != nil {
+				t.Fatalf("%d. %s: failed to write record: %s", i, tc.inFormat, err)
+			}
+		}
+		if err := out.Close(); err != nil {
+			t.Fatalf("%d. %s: failed to close record writer: %s", i, tc.inFormat, err)
+		}
+		if buf.String() != tc.out {
+			t.Errorf("%d. %s: expected '%s', got '%s'", i, tc.inFormat, tc.out, buf.String())
+		}
+	}
+
+	if _, err := newRecordWriter("xml", &bytes.Buffer{}); err == nil {
+		t.Errorf("xml: expected error, got nil")
+	}
+}
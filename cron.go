@@ -5,6 +5,8 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 )
 
 var (
@@ -12,6 +14,10 @@ var (
 
 	weekdaysNumberRegex = regexp.MustCompile(`(\d{1,2}w)|(w\d{1,2})`)
 	lastDayOffsetRegex  = regexp.MustCompile(`l-(\d{1,2})`)
+	daysListRegex       = regexp.MustCompile(`^\d{1,2}(,\d{1,2})*$`)
+
+	// nthKeys are the words of the nth day of the week of the month (i.e. SUN#2: "the second Sunday")
+	nthKeys = []LocaleKey{first, second, third, fourth, fifth}
 )
 
 type (
@@ -20,10 +26,25 @@ type (
 		isVerbose          bool
 		isDOWStartsAtOne   bool
 		is24HourTimeFormat bool
-
+		isUseOrdinals      bool
+		isBidiIsolates     bool
+		timeFormat         string
+		timeLayout         *timeLayout
+		isStrictValidation bool
+		isHashEnabled      bool
+		isSymbolicHash     bool
+		hashSeed           string
+		dialect            Dialect
+		fieldLayout        FieldLayout
+		location           *time.Location
+		viewerLocation     *time.Location
+
+		now     func() time.Time // Reference time of the time zone conversions, time.Now by default
 		logger  Logger
-		parser  Parser
+		parser  *cronParser
 		locales map[LocaleType]Locale
+
+		descParsers sync.Map // Parsers of FromDescription by locale type, built on first use
 	}
 
 	// Logger is the logging interface for expression descriptor.
@@ -46,8 +67,14 @@ func NewDescriptor(options ...Option) (exprDesc *ExpressionDescriptor, err error
 
 	// Init defaults
 	if exprDesc.parser == nil {
-		exprDesc.parser = &cronParser{
-			isDOWStartsAtOne: exprDesc.isDOWStartsAtOne,
+		exprDesc.parser = exprDesc.newParser()
+	}
+	if exprDesc.now == nil {
+		exprDesc.now = time.Now
+	}
+	if exprDesc.timeFormat != "" {
+		if exprDesc.timeLayout, err = parseTimeLayout(exprDesc.timeFormat); err != nil {
+			return nil, fmt.Errorf("invalid time format '%s': %w", exprDesc.timeFormat, err)
 		}
 	}
 
@@ -63,36 +90,101 @@ func NewDescriptor(options ...Option) (exprDesc *ExpressionDescriptor, err error
 		exprDesc.locales[Locale_en] = localeLoader[0]
 	}
 
+	// Resolve the missing keys of the locales through their parents
+	withParents := make(map[LocaleType]Locale, len(exprDesc.locales))
+	for typ, locale := range exprDesc.locales {
+		if withParents[typ], err = exprDesc.withParents(locale); err != nil {
+			return nil, fmt.Errorf("failed to init locale %s: %w", typ, err)
+		}
+	}
+	exprDesc.locales = withParents
+
 	return exprDesc, nil
 }
 
+// NewParser returns a new CRON expression parser based on the list of options.
+// Only the options related to parsing (i.e. DayOfWeekStartsAtOne, StrictValidation, HashSeed, SetDialect,
+// SetFieldLayout, WithLocation) take effect.
+func NewParser(options ...Option) Parser {
+	exprDesc := &ExpressionDescriptor{}
+	for _, option := range options {
+		option(exprDesc)
+	}
+	return exprDesc.newParser()
+}
+
+func (e *ExpressionDescriptor) newParser() *cronParser {
+	return &cronParser{
+		isDOWStartsAtOne:   e.isDOWStartsAtOne || e.dialect.isDOWStartsAtOne(),
+		isStrictValidation: e.isStrictValidation,
+		isHashEnabled:      e.isHashEnabled,
+		hashSeed:           e.hashSeed,
+		dialect:            e.dialect,
+		fieldLayout:        e.fieldLayout,
+		location:           e.location,
+	}
+}
+
 // ToDescription converts the CRON expression to the human readable string in specified locale.
 // If the specified locale had not been loaded by the CRON expression descriptor, the result will be
 // returned in English (Locale_en) by default.
 //
 // To configure supported locales of the CRON expression descriptor, please see the SetLocales() option.
 func (e *ExpressionDescriptor) ToDescription(expr string, loc LocaleType) (desc string, err error) {
-	var exprParts []string
-	if exprParts, err = e.parser.Parse(expr); err != nil {
-		return "", fmt.Errorf("failed to parse CRON expression: %w", err)
+	parts, err := e.ToDescriptionParts(expr, loc)
+	if err != nil {
+		return "", err
 	}
+	return parts.String(), nil
+}
 
+// ToDescriptionParts converts the CRON expression to the human readable description in specified locale,
+// split into the segments which describe the time of day, day of month, day of week, month and year.
+// The segments joined together are the description returned by ToDescription.
+func (e *ExpressionDescriptor) ToDescriptionParts(expr string, loc LocaleType) (desc *Description, err error) {
 	locale := e.getLocale(loc)
 
+	special, err := e.parser.parseSpecial(expr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse CRON expression: %w", err)
+	}
+	if special != nil {
+		return newDescription([5]string{e.getSpecialDescription(special, locale)}, locale, e.isVerbose, e.isBidiIsolates && IsRightToLeft(locale.GetLocaleType())), nil
+	}
+
+	var exprParts []string
+	var tokens *exprTokens
+	if exprParts, tokens, err = e.parser.parse(expr); err != nil {
+		return nil, fmt.Errorf("failed to parse CRON expression: %w", err)
+	}
+
 	var timeSegment = e.getTimeOfDayDescription(exprParts, locale)
+	var zoneDesc = e.getZoneDescription(exprParts, tokens.location, locale)
 	var dayOfMonthDesc = e.getDayOfMonthDescription(exprParts, locale)
 	var monthDesc = e.getMonthDescription(exprParts, locale)
 	var dayOfWeekDesc = e.getDayOfWeekDescription(exprParts, locale)
 	var yearDesc = e.getYearDescription(exprParts, locale)
 
-	desc = timeSegment + dayOfMonthDesc + dayOfWeekDesc + monthDesc + yearDesc
-	desc = transformVerbosity(desc, locale, e.isVerbose)
-	desc = strings.Join(strings.Fields(desc), " ")
-	desc = strings.Replace(desc, " ,", ",", -1)
-	runes := []rune(desc)
-	runes[0] = []rune(strings.ToUpper(string(runes[0])))[0]
+	// Describe the hashed fields symbolically instead of with their resolved values
+	if e.isSymbolicHash {
+		if hashDescs, hashParts := e.getHashDescriptions(tokens, locale); hashParts != nil {
+			if hashDescs[FieldSecond] != "" || hashDescs[FieldMinute] != "" || hashDescs[FieldHour] != "" {
+				timeSegment = e.getHashedTimeOfDayDescription(hashParts, hashDescs, locale)
+			}
+			if hashDescs[FieldDayOfMonth] != "" {
+				dayOfMonthDesc = hashDescs[FieldDayOfMonth]
+			}
+			if hashDescs[FieldMonth] != "" {
+				monthDesc = hashDescs[FieldMonth]
+			}
+			if hashDescs[FieldDayOfWeek] != "" {
+				dayOfWeekDesc = hashDescs[FieldDayOfWeek]
+			}
+		}
+	}
 
-	return string(runes), nil
+	segments := [5]string{timeSegment + zoneDesc, dayOfMonthDesc, dayOfWeekDesc, monthDesc, yearDesc}
+	return newDescription(segments, locale, e.isVerbose, e.isBidiIsolates && IsRightToLeft(locale.GetLocaleType())), nil
 }
 
 func (e *ExpressionDescriptor) log(format string, v ...interface{}) {
@@ -117,7 +209,7 @@ func (e *ExpressionDescriptor) getTimeOfDayDescription(exprParts []string, local
 
 	if !containsAny(second, specialChars) && !containsAny(minute, specialChars) && !containsAny(hour, specialChars) {
 		// specific time of day (i.e. 10:14:00)
-		desc += locale.GetString(atSpace) + formatTime(hour, minute, second, locale, e.is24HourTimeFormat)
+		desc += locale.GetString(atSpace) + e.formatTime(hour, minute, second, locale)
 	} else if second == "" &&
 		strings.Index(minute, "-") > -1 &&
 		!(strings.Index(minute, ",") > -1) &&
@@ -126,8 +218,8 @@ func (e *ExpressionDescriptor) getTimeOfDayDescription(exprParts []string, local
 		// minute range in single hour (i.e. 0-10 11)
 		idx := strings.Index(minute, "-")
 		desc += sprintf(locale.GetString(everyMinuteBetweenX0AndX1),
-			formatTime(hour, minute[:idx], "", locale, e.is24HourTimeFormat),
-			formatTime(hour, minute[idx+1:], "", locale, e.is24HourTimeFormat))
+			e.formatTime(hour, minute[:idx], "", locale),
+			e.formatTime(hour, minute[idx+1:], "", locale))
 	} else if second == "" &&
 		strings.Index(hour, ",") > -1 &&
 		strings.Index(hour, "-") == -1 &&
@@ -138,7 +230,7 @@ func (e *ExpressionDescriptor) getTimeOfDayDescription(exprParts []string, local
 		desc += locale.GetString(at)
 		for i, p := range hourParts {
 			desc += " "
-			desc += formatTime(p, minute, "", locale, e.is24HourTimeFormat)
+			desc += e.formatTime(p, minute, "", locale)
 			if i < len(hourParts)-2 {
 				desc += ", "
 			}
@@ -175,7 +267,7 @@ func (e *ExpressionDescriptor) getSecondsDescription(exprParts []string, locale
 			return s
 		},
 		func(s string) string {
-			return sprintf(locale.GetString(everyX0Seconds), s)
+			return sprintf(pluralFormat(locale, everyX0Seconds, s), s)
 		},
 		func(s string) string {
 			return locale.GetString(secondsX0ThroughX1PastTheMinute)
@@ -186,12 +278,12 @@ func (e *ExpressionDescriptor) getSecondsDescription(exprParts []string, locale
 			}
 			sInt, _ := strconv.Atoi(s)
 			if sInt < 20 {
-				return locale.GetString(atX0SecondsPastTheMinute)
+				return pluralFormat(locale, atX0SecondsPastTheMinute, s)
 			}
 			if msg := locale.GetString(atX0SecondsPastTheMinuteGt20); msg != "" {
 				return msg
 			}
-			return locale.GetString(atX0SecondsPastTheMinute)
+			return pluralFormat(locale, atX0SecondsPastTheMinute, s)
 		},
 		locale,
 	)
@@ -227,13 +319,35 @@ func (e *ExpressionDescriptor) getDayOfMonthDescription(exprParts []string, loca
 		// Handle "last day offset" (i.e. L-5:  "5 days before the last day of the month")
 		lastDayOffsetMatches := lastDayOffsetRegex.FindAllStringSubmatch(dom, -1)
 		if len(lastDayOffsetMatches) > 0 {
-			desc = sprintf(locale.GetString(commaDaysBeforeTheLastDayOfTheMonth), lastDayOffsetMatches[0][1])
+			offset := lastDayOffsetMatches[0][1]
+			if desc = e.getOrdinalLastDayOffsetDescription(offset, locale); desc == "" {
+				desc = sprintf(pluralFormat(locale, commaDaysBeforeTheLastDayOfTheMonth, offset), offset)
+			}
 			break
 		}
 		// * dayOfMonth and dayOfWeek specified so use dayOfWeek verbiage instead
 		if dom == "*" && exprParts[5] != "*" {
 			return ""
 		}
+		// Days of the month in ordinal numbers (i.e. 1,15: "on the 1st and 15th of the month")
+		if e.isUseOrdinals && daysListRegex.MatchString(dom) && locale.GetOrdinal(1) != "" {
+			getFormat := func(s string) string {
+				return locale.GetString(commaOnTheX0OfTheMonth)
+			}
+			desc = getSegmentDescription(
+				dom,
+				locale.GetString(commaEveryDay),
+				func(s string) string {
+					day, _ := strconv.Atoi(s)
+					return locale.GetOrdinal(day)
+				},
+				getFormat,
+				getFormat,
+				getFormat,
+				locale,
+			)
+			break
+		}
 		desc = getSegmentDescription(
 			dom,
 			locale.GetString(commaEveryDay),
@@ -250,7 +364,7 @@ func (e *ExpressionDescriptor) getDayOfMonthDescription(exprParts []string, loca
 				if s == "1" {
 					return locale.GetString(commaEveryDay)
 				}
-				return locale.GetString(commaEveryX0Days)
+				return pluralFormat(locale, commaEveryX0Days, s)
 			},
 			func(s string) string {
 				return locale.GetString(commaBetweenDayX0AndX1OfTheMonth)
@@ -274,15 +388,17 @@ func (e *ExpressionDescriptor) getMonthDescription(exprParts []string, locale Lo
 		"",
 		func(s string) string {
 			sInt, _ := strconv.Atoi(s)
+			if sInt < 1 || sInt > len(monthNames) {
+				return s
+			}
 			return monthNames[sInt-1]
 		},
 		func(s string) string {
 			sInt, _ := strconv.Atoi(s)
 			if sInt == 1 {
-				return "" // rather than "every 1 months" just return empty string
+				return "" // every month is not described, as *
 			}
-			return sprintf(locale.GetString(commaEveryX0Months), s)
-
+			return sprintf(pluralFormat(locale, commaEveryX0Months, s), s)
 		},
 		func(s string) string {
 			if msg := locale.GetString(commaMonthX0ThroughMonthX1); msg != "" {
@@ -322,14 +438,17 @@ func (e *ExpressionDescriptor) getDayOfWeekDescription(exprParts []string, local
 				exp = strings.Replace(exp, "l", "", -1)
 			}
 			expInt, _ := strconv.Atoi(exp)
+			if expInt < 0 || expInt >= len(daysOfWeekNames) {
+				return s
+			}
 			return daysOfWeekNames[expInt]
 		},
 		func(s string) string {
 			sInt, _ := strconv.Atoi(s)
 			if sInt == 1 {
-				return "" // rather than "every 1 days" just return empty string
+				return "" // every day of the week is not described, as *
 			}
-			return sprintf(locale.GetString(commaEveryX0DaysOfTheWeek), s)
+			return sprintf(pluralFormat(locale, commaEveryX0DaysOfTheWeek, s), s)
 		},
 		func(s string) string {
 			return locale.GetString(commaX0ThroughX1)
@@ -337,20 +456,7 @@ func (e *ExpressionDescriptor) getDayOfWeekDescription(exprParts []string, local
 		func(s string) string {
 			format := ""
 			if idx := strings.Index(s, "#"); idx > -1 {
-				dowOfMonthNum := s[idx+1:]
-				dowOfMonthDesc := ""
-				switch dowOfMonthNum {
-				case "1":
-					dowOfMonthDesc = locale.GetString(first)
-				case "2":
-					dowOfMonthDesc = locale.GetString(second)
-				case "3":
-					dowOfMonthDesc = locale.GetString(third)
-				case "4":
-					dowOfMonthDesc = locale.GetString(fourth)
-				case "5":
-					dowOfMonthDesc = locale.GetString(fifth)
-				}
+				dowOfMonthDesc := getNthDescription(s[idx+1:], locale)
 				format = locale.GetString(commaOnThe) + dowOfMonthDesc + locale.GetString(spaceX0OfTheMonth)
 			} else if strings.Index(s, "l") > -1 {
 				format = locale.GetString(commaOnTheLastX0OfTheMonth)
@@ -370,6 +476,36 @@ func (e *ExpressionDescriptor) getDayOfWeekDescription(exprParts []string, local
 	return desc
 }
 
+// getOrdinalLastDayOffsetDescription returns the description of the last day offset in ordinal numbers
+// (i.e. L-1: "on the 2nd to last day of the month"), or "" if ordinals are not used or the locale has none.
+func (e *ExpressionDescriptor) getOrdinalLastDayOffsetDescription(offset string, locale Locale) string {
+	n, err := strconv.Atoi(offset)
+	format := locale.GetString(commaOnTheX0ToLastDayOfTheMonth)
+	if !e.isUseOrdinals || err != nil || n < 1 || format == "" {
+		return ""
+	}
+	ordinal := locale.GetOrdinal(n + 1)
+	if ordinal == "" {
+		return ""
+	}
+	return sprintf(format, ordinal)
+}
+
+// getNthDescription returns the description of the nth day of the week of the month: the word of the locale
+// (i.e. "second"), or the ordinal number if the locale has no word for it.
+func getNthDescription(nth string, locale Locale) string {
+	n, _ := strconv.Atoi(nth)
+	if n >= 1 && n <= len(nthKeys) {
+		if word := locale.GetString(nthKeys[n-1]); word != "" {
+			return word
+		}
+	}
+	if ordinal := locale.GetOrdinal(n); ordinal != "" {
+		return ordinal
+	}
+	return nth
+}
+
 func (e *ExpressionDescriptor) getYearDescription(exprParts []string, locale Locale) string {
 	desc := getSegmentDescription(
 		exprParts[6],
@@ -378,7 +514,7 @@ func (e *ExpressionDescriptor) getYearDescription(exprParts []string, locale Loc
 			return s // Note: Not handle the cases when year is not in full, e.g.: 93, 99
 		},
 		func(s string) string {
-			return sprintf(locale.GetString(commaEveryX0Years), s)
+			return sprintf(pluralFormat(locale, commaEveryX0Years, s), s)
 		},
 		func(s string) string {
 			if msg := locale.GetString(commaYearX0ThroughYearX1); msg != "" {
@@ -398,12 +534,44 @@ func (e *ExpressionDescriptor) getYearDescription(exprParts []string, locale Loc
 	return desc
 }
 
+// getSpecialDescription describes the CRON expressions which have no time fields (@reboot, @every and rate()).
+func (e *ExpressionDescriptor) getSpecialDescription(special *specialExpr, locale Locale) string {
+	if special.isReboot {
+		return locale.GetString(atSystemStartup)
+	}
+
+	// Describe the interval in the largest unit which divides it, i.e. 1h30m => every 90 minutes
+	day := 24 * time.Hour
+	switch {
+	case special.every == day:
+		return locale.GetString(everyDay)
+	case special.every%day == 0:
+		days := int(special.every / day)
+		return sprintf(locale.GetPluralString(everyX0Days, days), strconv.Itoa(days))
+	case special.every == time.Hour:
+		return locale.GetString(everyHour)
+	case special.every%time.Hour == 0:
+		hours := int(special.every / time.Hour)
+		return sprintf(locale.GetPluralString(everyX0Hours, hours), strconv.Itoa(hours))
+	case special.every == time.Minute:
+		return locale.GetString(everyMinute)
+	case special.every%time.Minute == 0:
+		minutes := int(special.every / time.Minute)
+		return sprintf(locale.GetPluralString(everyX0Minutes, minutes), strconv.Itoa(minutes))
+	case special.every == time.Second:
+		return locale.GetString(everySecond)
+	default:
+		seconds := int(special.every / time.Second)
+		return sprintf(locale.GetPluralString(everyX0Seconds, seconds), strconv.Itoa(seconds))
+	}
+}
+
 func (e *ExpressionDescriptor) getLocale(loc LocaleType) Locale {
-	v, ok := e.locales[loc]
-	if !ok {
-		return e.locales[Locale_en] // Fall back to default
+	if v, ok := e.locales[loc]; ok {
+		return v
 	}
-	return v
+	// Fall back to the loaded locale of the language, i.e. zh_TW for "zh-Hant-HK", then to default
+	return e.locales[e.NegotiateLocale(string(loc))]
 }
 
 func containsAny(s string, matches []rune) bool {
@@ -566,7 +734,7 @@ func (e *ExpressionDescriptor) getMinutesDescription(exprParts []string, locale
 			return s
 		},
 		func(s string) string {
-			return sprintf(locale.GetString(everyX0Minutes), s)
+			return sprintf(pluralFormat(locale, everyX0Minutes, s), s)
 		},
 		func(s string) string {
 			return locale.GetString(minutesX0ThroughX1PastTheHour)
@@ -577,12 +745,12 @@ func (e *ExpressionDescriptor) getMinutesDescription(exprParts []string, locale
 			}
 			sInt, _ := strconv.Atoi(s)
 			if sInt < 20 {
-				return locale.GetString(atX0MinutesPastTheHour)
+				return pluralFormat(locale, atX0MinutesPastTheHour, s)
 			}
 			if msg := locale.GetString(atX0MinutesPastTheHourGt20); msg != "" {
 				return msg
 			}
-			return locale.GetString(atX0MinutesPastTheHour)
+			return pluralFormat(locale, atX0MinutesPastTheHour, s)
 		},
 		locale)
 
@@ -594,10 +762,10 @@ func (e *ExpressionDescriptor) getHoursDescription(exprParts []string, locale Lo
 		exprParts[2],
 		locale.GetString(everyHour),
 		func(s string) string {
-			return formatTime(s, "0", "", locale, e.is24HourTimeFormat)
+			return e.formatTime(s, "0", "", locale)
 		},
 		func(s string) string {
-			return sprintf(locale.GetString(everyX0Hours), s)
+			return sprintf(pluralFormat(locale, everyX0Hours, s), s)
 		},
 		func(s string) string {
 			return locale.GetString(betweenX0AndX1)
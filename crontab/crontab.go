@@ -0,0 +1,319 @@
+// Package crontab parses the crontab files into typed entries: the environment variables, the scheduled commands
+// and the comments, as they're read by Vixie cron and its descendants (cronie, ISC cron).
+//
+// The schedules are not validated, use the cron package to describe or validate the CRON expressions of the entries.
+package crontab
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+const (
+	EntryBlank    EntryType = iota // Empty line
+	EntryComment                   // Comment line, starts with #
+	EntryEnv                       // Environment variable, i.e. MAILTO=root
+	EntrySchedule                  // Scheduled command, i.e. 0 9 * * MON-FRI /usr/bin/backup
+	EntryInvalid                   // Line which is not a crontab entry, see Entry.Err
+	EntryText                      // Line which doesn't look like a schedule, with BareExpressions (i.e. the output of another app)
+)
+
+type (
+	// EntryType is the type of a line of the crontab.
+	EntryType int
+
+	// Entry is a line of the crontab.
+	Entry struct {
+		Type       EntryType
+		LineNumber int    // Number of the line, starts at 1
+		Line       string // Line as written in the crontab, without the line break
+
+		// Comment is the text of the comment after #, for EntryComment.
+		Comment string
+
+		// Name and Value are the name and the unquoted value of the environment variable, for EntryEnv.
+		Name  string
+		Value string
+
+		// Expression is the CRON expression of the schedule (i.e. "0 9 * * MON-FRI" or "@every 1h30m"), for EntrySchedule.
+		Expression string
+		// TimeZone is the time zone of the CRON_TZ= (or TZ=) variable the schedule follows, or of the CRON_TZ= prefix
+		// of the line (i.e. "CRON_TZ=Asia/Tokyo 0 9 * * *"), "" if none.
+		TimeZone string
+		// User is the user the command runs as, in the system crontabs (see SystemCrontab).
+		User string
+		// Command is the command up to the first unescaped %, with the escaped \% unescaped.
+		Command string
+		// Input is the text after the first unescaped % which is sent to the command on stdin,
+		// with the other unescaped % as line breaks.
+		Input string
+
+		// Err is the reason the line is not a crontab entry, for EntryInvalid.
+		Err error
+	}
+
+	// Scanner reads the entries of a crontab line by line.
+	Scanner struct {
+		reader *bufio.Reader
+		entry  Entry
+		err    error
+
+		lineNumber        int
+		timeZone          string // Value of the last CRON_TZ= or TZ= variable
+		isSystemCrontab   bool
+		isBareExpressions bool
+	}
+
+	// Option allows to configure the scanner.
+	Option func(scanner *Scanner)
+)
+
+var (
+	envRegex = regexp.MustCompile(`^([A-Za-z_][A-Za-z0-9_]*)\s*=\s*(.*)$`)
+	// fieldRegex matches the tokens which can only be a field of a CRON expression, not a user or a command.
+	fieldRegex = regexp.MustCompile(`^(?i:[0-9*?/,#()\-lwh]|jan|feb|mar|apr|may|jun|jul|aug|sep|oct|nov|dec|mon|tue|wed|thu|fri|sat|sun)+$`)
+)
+
+// SystemCrontab configures the scanner to read the user column of the system crontabs (/etc/crontab and /etc/cron.d),
+// which is between the schedule and the command, i.e. "0 9 * * * root /usr/bin/backup".
+func SystemCrontab(v bool) Option {
+	return func(scanner *Scanner) {
+		scanner.isSystemCrontab = v
+	}
+}
+
+// BareExpressions configures the scanner to also read the lines of CRON expressions which are not crontab entries,
+// i.e. the output of another app: the lines of 6 or 7 fields without command (i.e. "0 */10 9 * * 1-5 2020")
+// and the EventBridge expressions (i.e. "cron(0 12 * * ? *)" or "rate(5 minutes)", followed by an optional target).
+// The lines which don't start like a schedule (i.e. "Backup done") are EntryText instead of EntryInvalid.
+func BareExpressions(v bool) Option {
+	return func(scanner *Scanner) {
+		scanner.isBareExpressions = v
+	}
+}
+
+// NewScanner returns a new scanner which reads the crontab from r.
+func NewScanner(r io.Reader, options ...Option) *Scanner {
+	scanner := &Scanner{reader: bufio.NewReader(r)}
+	for _, option := range options {
+		option(scanner)
+	}
+	return scanner
+}
+
+// Parse reads all the entries of the crontab from r.
+func Parse(r io.Reader, options ...Option) (entries []Entry, err error) {
+	scanner := NewScanner(r, options...)
+	for scanner.Scan() {
+		entries = append(entries, scanner.Entry())
+	}
+	return entries, scanner.Err()
+}
+
+// Scan advances the scanner to the next line, which is then available through the Entry method.
+// It returns false when the scan stops, either by reaching the end of the input or an error.
+func (s *Scanner) Scan() bool {
+	if s.err != nil {
+		return false
+	}
+	line, err := s.reader.ReadString('\n')
+	if err != nil && err != io.EOF {
+		s.err = fmt.Errorf("failed to read line %d: %w", s.lineNumber+1, err)
+		return false
+	}
+	if err == io.EOF && line == "" {
+		return false
+	}
+
+	s.lineNumber++
+	line = strings.TrimRight(line, "\r\n")
+	s.entry = s.parseLine(line)
+	s.entry.LineNumber = s.lineNumber
+	s.entry.Line = line
+	return true
+}
+
+// Entry returns the entry of the line read by the last call to Scan.
+func (s *Scanner) Entry() Entry {
+	return s.entry
+}
+
+// Err returns the error which stopped the scan, nil if it reached the end of the input.
+func (s *Scanner) Err() error {
+	return s.err
+}
+
+func (s *Scanner) parseLine(line string) Entry {
+	trimmed := strings.TrimSpace(line)
+	switch {
+	case trimmed == "":
+		return Entry{Type: EntryBlank}
+	case strings.HasPrefix(trimmed, "#"):
+		return Entry{Type: EntryComment, Comment: strings.TrimSpace(trimmed[1:])}
+	}
+
+	// Time zone prefix of the line (i.e. CRON_TZ=Asia/Tokyo 0 9 * * *), only applies to the line
+	if parts := strings.Fields(trimmed); len(parts) > 1 && isTimeZoneVariable(parts[0]) && !strings.HasSuffix(parts[0], "=") {
+		entry := s.parseSchedule(strings.TrimSpace(trimmed[len(parts[0]):]))
+		if entry.Type == EntrySchedule {
+			entry.TimeZone = parts[0][strings.Index(parts[0], "=")+1:]
+		}
+		return entry
+	}
+
+	if matches := envRegex.FindStringSubmatch(trimmed); matches != nil {
+		entry := Entry{Type: EntryEnv, Name: matches[1], Value: unquote(strings.TrimSpace(matches[2]))}
+		if isTimeZoneVariable(entry.Name + "=") {
+			s.timeZone = entry.Value
+		}
+		return entry
+	}
+
+	if s.isBareExpressions && !isScheduleStart(trimmed) {
+		return Entry{Type: EntryText}
+	}
+	entry := s.parseSchedule(trimmed)
+	if entry.Type == EntrySchedule && entry.TimeZone == "" {
+		entry.TimeZone = s.timeZone
+	}
+	return entry
+}
+
+// parseSchedule parses the line of a scheduled command: the CRON expression, the user and the command.
+func (s *Scanner) parseSchedule(line string) Entry {
+	// EventBridge expression (i.e. cron(0 12 * * ? *), rate(5 minutes)), the remaining is the target
+	lower := strings.ToLower(line)
+	if s.isBareExpressions && (strings.HasPrefix(lower, "cron(") || strings.HasPrefix(lower, "rate(")) {
+		idx := strings.Index(line, ")")
+		if idx < 0 {
+			return Entry{Type: EntryInvalid, Err: fmt.Errorf("unterminated %s", line[:5])}
+		}
+		return Entry{Type: EntrySchedule, Expression: line[:idx+1], Command: strings.TrimSpace(line[idx+1:])}
+	}
+
+	parts := strings.Fields(line)
+	n := 5
+	switch {
+	case strings.HasPrefix(parts[0], "@"): // Predefined macro (i.e. @daily, @every 1h30m)
+		n = 1
+		if strings.EqualFold(parts[0], "@every") && len(parts) > 1 {
+			n = 2
+		}
+	case s.isBareExpressions && len(parts) >= 6 && len(parts) <= 7 && allFields(parts):
+		n = len(parts)
+	case len(parts) < 5:
+		return Entry{Type: EntryInvalid, Err: fmt.Errorf("schedule has %d fields, 5 fields required", len(parts))}
+	}
+
+	entry := Entry{Type: EntrySchedule, Expression: strings.Join(parts[:n], " ")}
+	rest := strings.TrimSpace(afterFields(line, n))
+	if s.isSystemCrontab {
+		userParts := strings.Fields(rest)
+		if len(userParts) == 0 {
+			return Entry{Type: EntryInvalid, Err: fmt.Errorf("schedule has no user")}
+		}
+		entry.User = userParts[0]
+		rest = strings.TrimSpace(afterFields(rest, 1))
+	}
+	if rest == "" && !s.isBareExpressions {
+		return Entry{Type: EntryInvalid, Err: fmt.Errorf("schedule has no command")}
+	}
+	entry.Command, entry.Input = splitCommand(rest)
+	return entry
+}
+
+// afterFields returns the text of the line after its first n whitespace-separated fields, as written.
+func afterFields(line string, n int) string {
+	for i := 0; i < n; i++ {
+		line = strings.TrimLeft(line, " \t")
+		idx := strings.IndexAny(line, " \t")
+		if idx < 0 {
+			return ""
+		}
+		line = line[idx:]
+	}
+	return line
+}
+
+// splitCommand splits the command at the first unescaped %, the other unescaped % of the input are line breaks.
+// The command is trimmed, as the spaces before % are not part of it.
+func splitCommand(s string) (command, input string) {
+	var b strings.Builder
+	isInput := false
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && i+1 < len(s) && s[i+1] == '%':
+			b.WriteByte('%')
+			i++
+		case s[i] == '%' && !isInput:
+			command = strings.TrimSpace(b.String())
+			b.Reset()
+			isInput = true
+		case s[i] == '%':
+			b.WriteByte('\n')
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	if !isInput {
+		return b.String(), ""
+	}
+	return command, b.String()
+}
+
+// isTimeZoneVariable reports whether the token assigns the CRON_TZ or TZ variable, i.e. "CRON_TZ=Asia/Tokyo".
+func isTimeZoneVariable(token string) bool {
+	idx := strings.Index(token, "=")
+	if idx < 0 {
+		return false
+	}
+	name := strings.ToUpper(strings.TrimSpace(token[:idx]))
+	return name == "CRON_TZ" || name == "TZ"
+}
+
+// isScheduleStart reports whether the line starts like a schedule: a field, a macro, cron( or rate(.
+func isScheduleStart(line string) bool {
+	lower := strings.ToLower(line)
+	if strings.HasPrefix(lower, "@") || strings.HasPrefix(lower, "cron(") || strings.HasPrefix(lower, "rate(") {
+		return true
+	}
+	return fieldRegex.MatchString(strings.Fields(line)[0])
+}
+
+func allFields(parts []string) bool {
+	for _, part := range parts {
+		if !fieldRegex.MatchString(part) {
+			return false
+		}
+	}
+	return true
+}
+
+// unquote removes the matching single or double quotes around the value, as cron does.
+func unquote(value string) string {
+	if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+		return value[1 : len(value)-1]
+	}
+	return value
+}
+
+func (t EntryType) String() string {
+	switch t {
+	case EntryBlank:
+		return "blank"
+	case EntryComment:
+		return "comment"
+	case EntryEnv:
+		return "env"
+	case EntrySchedule:
+		return "schedule"
+	case EntryInvalid:
+		return "invalid"
+	case EntryText:
+		return "text"
+	}
+	return "unknown"
+}
@@ -0,0 +1,173 @@
+package crontab
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	type testCase struct {
+		name      string
+		inLine    string
+		inOptions []Option
+		out       Entry
+	}
+
+	tcs := []testCase{
+		{name: "blank", inLine: "  ", out: Entry{Type: EntryBlank}},
+		{name: "comment", inLine: "# m h dom mon dow command", out: Entry{Type: EntryComment, Comment: "m h dom mon dow command"}},
+		{name: "env", inLine: "MAILTO=root", out: Entry{Type: EntryEnv, Name: "MAILTO", Value: "root"}},
+		{name: "env with spaces and quotes", inLine: `SHELL = "/bin/bash"`, out: Entry{Type: EntryEnv, Name: "SHELL", Value: "/bin/bash"}},
+		{
+			name:   "schedule",
+			inLine: "0 9 * * MON-FRI /usr/bin/backup --full",
+			out:    Entry{Type: EntrySchedule, Expression: "0 9 * * MON-FRI", Command: "/usr/bin/backup --full"},
+		},
+		{
+			name:   "schedule with names and tabs",
+			inLine: "30\t4\t1,15\tJAN-JUN\t*\tls  -la",
+			out:    Entry{Type: EntrySchedule, Expression: "30 4 1,15 JAN-JUN *", Command: "ls  -la"},
+		},
+		{
+			name:   "macro",
+			inLine: "@daily /usr/bin/cleanup",
+			out:    Entry{Type: EntrySchedule, Expression: "@daily", Command: "/usr/bin/cleanup"},
+		},
+		{
+			name:   "every macro",
+			inLine: "@every 1h30m /usr/bin/sync",
+			out:    Entry{Type: EntrySchedule, Expression: "@every 1h30m", Command: "/usr/bin/sync"},
+		},
+		{
+			name:   "percent in command",
+			inLine: `0 0 * * * date +\%Y-\%m-\%d%line 1%line 2`,
+			out:    Entry{Type: EntrySchedule, Expression: "0 0 * * *", Command: "date +%Y-%m-%d", Input: "line 1\nline 2"},
+		},
+		{
+			name:   "space before percent",
+			inLine: "0 9 * * * /bin/a % in",
+			out:    Entry{Type: EntrySchedule, Expression: "0 9 * * *", Command: "/bin/a", Input: " in"},
+		},
+		{
+			name:      "system crontab",
+			inLine:    "17 * * * *  root    cd / && run-parts --report /etc/cron.hourly",
+			inOptions: []Option{SystemCrontab(true)},
+			out:       Entry{Type: EntrySchedule, Expression: "17 * * * *", User: "root", Command: "cd / && run-parts --report /etc/cron.hourly"},
+		},
+		{
+			name:      "system crontab macro",
+			inLine:    "@reboot root /usr/bin/boot",
+			inOptions: []Option{SystemCrontab(true)},
+			out:       Entry{Type: EntrySchedule, Expression: "@reboot", User: "root", Command: "/usr/bin/boot"},
+		},
+		{
+			name:   "time zone prefix",
+			inLine: "CRON_TZ=Asia/Tokyo 0 9 * * * /usr/bin/report",
+			out:    Entry{Type: EntrySchedule, Expression: "0 9 * * *", TimeZone: "Asia/Tokyo", Command: "/usr/bin/report"},
+		},
+		{
+			name:      "bare expression",
+			inLine:    "0 */10 9 * * 1-5 2020",
+			inOptions: []Option{BareExpressions(true)},
+			out:       Entry{Type: EntrySchedule, Expression: "0 */10 9 * * 1-5 2020"},
+		},
+		{
+			name:      "bare expression with names",
+			inLine:    "0 0 12 ? JAN MON#2",
+			inOptions: []Option{BareExpressions(true)},
+			out:       Entry{Type: EntrySchedule, Expression: "0 0 12 ? JAN MON#2"},
+		},
+		{
+			name:      "bare expression with command",
+			inLine:    "0 9 * * * backup now",
+			inOptions: []Option{BareExpressions(true)},
+			out:       Entry{Type: EntrySchedule, Expression: "0 9 * * *", Command: "backup now"},
+		},
+		{
+			name:      "EventBridge expression",
+			inLine:    "cron(0 12 * * ? *) my-target",
+			inOptions: []Option{BareExpressions(true)},
+			out:       Entry{Type: EntrySchedule, Expression: "cron(0 12 * * ? *)", Command: "my-target"},
+		},
+		{
+			name:      "text",
+			inLine:    "Backup done in 5 minutes",
+			inOptions: []Option{BareExpressions(true)},
+			out:       Entry{Type: EntryText},
+		},
+		{
+			name:   "text without bare expressions",
+			inLine: "Backup done in 5 minutes at night",
+			out:    Entry{Type: EntrySchedule, Expression: "Backup done in 5 minutes", Command: "at night"},
+		},
+	}
+
+	for i, tc := range tcs {
+		entries, err := Parse(strings.NewReader(tc.inLine+"\n"), tc.inOptions...)
+		if err != nil || len(entries) != 1 {
+			t.Errorf("%d. %s: expected 1 entry, got %d and error '%v'", i, tc.name, len(entries), err)
+			continue
+		}
+		tc.out.LineNumber, tc.out.Line = 1, tc.inLine
+		if !reflect.DeepEqual(entries[0], tc.out) {
+			t.Errorf("%d. %s: expected '%+v', got '%+v'", i, tc.name, tc.out, entries[0])
+		}
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tcs := []struct {
+		name      string
+		inLine    string
+		inOptions []Option
+	}{
+		{name: "too few fields", inLine: "0 9 * * "},
+		{name: "no command", inLine: "0 9 * * *"},
+		{name: "no user", inLine: "0 9 * * *", inOptions: []Option{SystemCrontab(true)}},
+		{name: "unterminated EventBridge expression", inLine: "cron(0 12 * * ? *", inOptions: []Option{BareExpressions(true)}},
+		{name: "too few fields of bare expression", inLine: "0 9 * *", inOptions: []Option{BareExpressions(true)}},
+	}
+
+	for i, tc := range tcs {
+		entries, err := Parse(strings.NewReader(tc.inLine), tc.inOptions...)
+		if err != nil || len(entries) != 1 {
+			t.Errorf("%d. %s: expected 1 entry, got %d and error '%v'", i, tc.name, len(entries), err)
+			continue
+		}
+		if entries[0].Type != EntryInvalid || entries[0].Err == nil {
+			t.Errorf("%d. %s: expected invalid entry, got '%+v'", i, tc.name, entries[0])
+		}
+	}
+}
+
+func TestParse_Crontab(t *testing.T) {
+	crontab := "# Backups\r\n" +
+		"SHELL=/bin/sh\r\n" +
+		"CRON_TZ=Europe/Berlin\r\n" +
+		"0 9 * * MON-FRI /usr/bin/backup\r\n" +
+		"\r\n" +
+		"CRON_TZ=\r\n" +
+		"@hourly /usr/bin/sync"
+
+	entries, err := Parse(strings.NewReader(crontab))
+	if err != nil {
+		t.Fatalf("expected nil, got error '%v'", err)
+	}
+
+	types := []EntryType{EntryComment, EntryEnv, EntryEnv, EntrySchedule, EntryBlank, EntryEnv, EntrySchedule}
+	if len(entries) != len(types) {
+		t.Fatalf("expected %d entries, got %d", len(types), len(entries))
+	}
+	for i, entry := range entries {
+		if entry.Type != types[i] || entry.LineNumber != i+1 {
+			t.Errorf("%d. expected %s entry on line %d, got %s entry on line %d", i, types[i], i+1, entry.Type, entry.LineNumber)
+		}
+	}
+	if entries[3].TimeZone != "Europe/Berlin" || entries[3].Line != "0 9 * * MON-FRI /usr/bin/backup" {
+		t.Errorf("expected schedule in Europe/Berlin, got '%+v'", entries[3])
+	}
+	if entries[6].TimeZone != "" {
+		t.Errorf("expected schedule without time zone, got '%s'", entries[6].TimeZone)
+	}
+}
@@ -0,0 +1,88 @@
+package cron
+
+import (
+	"strings"
+	"unicode"
+)
+
+type (
+	// Description is the human readable description of a CRON expression, split into the segments
+	// which describe the fields of the expression.
+	// The expressions which have no time fields (i.e. @reboot, @every 1h) are only described by TimeOfDay.
+	Description struct {
+		TimeOfDay  DescriptionSegment
+		DayOfMonth DescriptionSegment
+		DayOfWeek  DescriptionSegment
+		Month      DescriptionSegment
+		Year       DescriptionSegment
+
+		raw [5]string // Segments as they are joined in the description
+	}
+
+	// DescriptionSegment is the description of one or more fields of a CRON expression.
+	DescriptionSegment struct {
+		Text   string      // Description in the locale, empty if the fields need no description (i.e. every day)
+		Fields []FieldType // Fields the segment describes
+	}
+)
+
+// newDescription returns the description of the segments, ordered as they are joined in the description:
+// time of day, day of month, day of week, month and year.
+// The numbers of the segments are written in the digits of the locale, and the left-to-right fragments
+// are wrapped in directional isolates if isBidiIsolates.
+func newDescription(segments [5]string, locale Locale, isVerbose, isBidiIsolates bool) *Description {
+	desc := &Description{}
+	for i, segment := range segments {
+		desc.raw[i] = localizeDigits(transformVerbosity(segment, locale, isVerbose), locale)
+		if isBidiIsolates {
+			desc.raw[i] = isolateLeftToRight(desc.raw[i])
+		}
+	}
+
+	// Time of day starts the description, so it's capitalized as in the description
+	desc.TimeOfDay = newDescriptionSegment(desc.raw[0], FieldSecond, FieldMinute, FieldHour)
+	desc.TimeOfDay.Text = capitalize(desc.TimeOfDay.Text)
+	desc.DayOfMonth = newDescriptionSegment(desc.raw[1], FieldDayOfMonth)
+	desc.DayOfWeek = newDescriptionSegment(desc.raw[2], FieldDayOfWeek)
+	desc.Month = newDescriptionSegment(desc.raw[3], FieldMonth)
+	desc.Year = newDescriptionSegment(desc.raw[4], FieldYear)
+	return desc
+}
+
+// newDescriptionSegment cleans up the segment text, which starts with a separator (i.e. ", only on Monday")
+// when it's joined after another segment.
+func newDescriptionSegment(text string, fields ...FieldType) DescriptionSegment {
+	text = strings.Join(strings.Fields(text), " ")
+	text = strings.Replace(text, " ,", ",", -1)
+	text = strings.TrimLeftFunc(text, func(r rune) bool {
+		return unicode.IsSpace(r) || unicode.IsPunct(r)
+	})
+	return DescriptionSegment{Text: text, Fields: fields}
+}
+
+// Segments returns the non-empty segments of the description, in the order they appear in the description.
+func (d *Description) Segments() []DescriptionSegment {
+	segments := make([]DescriptionSegment, 0, 5)
+	for _, segment := range []DescriptionSegment{d.TimeOfDay, d.DayOfMonth, d.DayOfWeek, d.Month, d.Year} {
+		if segment.Text != "" {
+			segments = append(segments, segment)
+		}
+	}
+	return segments
+}
+
+// String returns the description as a single string, the same as ExpressionDescriptor.ToDescription.
+func (d *Description) String() string {
+	desc := strings.Join(strings.Fields(strings.Join(d.raw[:], "")), " ")
+	desc = strings.Replace(desc, " ,", ",", -1)
+	return capitalize(desc)
+}
+
+func capitalize(s string) string {
+	if s == "" {
+		return ""
+	}
+	runes := []rune(s)
+	runes[0] = []rune(strings.ToUpper(string(runes[0])))[0]
+	return string(runes)
+}
@@ -0,0 +1,113 @@
+package cron
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExpressionDescriptor_ToDescriptionParts(t *testing.T) {
+	type testCase struct {
+		name        string
+		inLocale    LocaleType
+		inExpr      string
+		outSegments []DescriptionSegment
+	}
+
+	timeOfDay := []FieldType{FieldSecond, FieldMinute, FieldHour}
+	tcs := []testCase{
+		{
+			name:     "all segments",
+			inLocale: Locale_en,
+			inExpr:   "30 11 * 3 1-5 2030",
+			outSegments: []DescriptionSegment{
+				{Text: "At 11:30 AM", Fields: timeOfDay},
+				{Text: "Monday through Friday", Fields: []FieldType{FieldDayOfWeek}},
+				{Text: "only in March", Fields: []FieldType{FieldMonth}},
+				{Text: "only in 2030", Fields: []FieldType{FieldYear}},
+			},
+		}, {
+			name:     "day of month",
+			inLocale: Locale_en,
+			inExpr:   "*/5 * L * *",
+			outSegments: []DescriptionSegment{
+				{Text: "Every 5 minutes", Fields: timeOfDay},
+				{Text: "on the last day of the month", Fields: []FieldType{FieldDayOfMonth}},
+			},
+		}, {
+			name:     "every day is not a segment",
+			inLocale: Locale_en,
+			inExpr:   "* * * * *",
+			outSegments: []DescriptionSegment{
+				{Text: "Every minute", Fields: timeOfDay},
+			},
+		}, {
+			name:     "special expression",
+			inLocale: Locale_en,
+			inExpr:   "@reboot",
+			outSegments: []DescriptionSegment{
+				{Text: "At system startup", Fields: timeOfDay},
+			},
+		}, {
+			name:     "other locale",
+			inLocale: Locale_fr,
+			inExpr:   "*/5 15 * * MON-FRI",
+			outSegments: []DescriptionSegment{
+				{Text: "Toutes les 5 minutes, de 03:00 PM à 03:59 PM", Fields: timeOfDay},
+				{Text: "de lundi à vendredi", Fields: []FieldType{FieldDayOfWeek}},
+			},
+		}, {
+			name:     "other locale separator",
+			inLocale: Locale_ja,
+			inExpr:   "0 12 * * 1",
+			outSegments: []DescriptionSegment{
+				{Text: "次において実施12:00 PM", Fields: timeOfDay},
+				{Text: "月曜日 にのみ", Fields: []FieldType{FieldDayOfWeek}},
+			},
+		},
+	}
+
+	exprDesc, err := NewDescriptor(SetLocales(LocaleAll))
+	if err != nil {
+		t.Errorf("failed to create expression descriptor: %s", err)
+		return
+	}
+	for i, tc := range tcs {
+		desc, err := exprDesc.ToDescriptionParts(tc.inExpr, tc.inLocale)
+		if err != nil {
+			t.Errorf("%d. %s: expected nil, got error '%v'", i, tc.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(desc.Segments(), tc.outSegments) {
+			t.Errorf("%d. %s: expected '%+v', got '%+v'", i, tc.name, tc.outSegments, desc.Segments())
+		}
+	}
+}
+
+func TestDescription_String(t *testing.T) {
+	for loc, localeTestCases := range testLocales {
+		for i, tc := range localeTestCases {
+			if tc.outErr != nil {
+				continue
+			}
+			exprDesc, err := NewDescriptor(
+				Verbose(tc.isVerbose),
+				DayOfWeekStartsAtOne(tc.isDOWStartsAtOne),
+				Use24HourTimeFormat(tc.is24HourTimeFormat),
+				SetLocales(loc),
+			)
+			if err != nil {
+				t.Errorf("failed to create expression descriptor: %s", err)
+				return
+			}
+
+			desc, err := exprDesc.ToDescriptionParts(tc.inExpr, loc)
+			if err != nil {
+				t.Errorf("%s %d. %s: expected nil, got error '%v'", loc, i, tc.inExpr, err)
+				continue
+			}
+			if desc.String() != tc.outDesc {
+				t.Errorf("%s %d. %s: expected '%v', got '%v'", loc, i, tc.inExpr, tc.outDesc, desc.String())
+			}
+		}
+	}
+}
@@ -0,0 +1,203 @@
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Dialect is the flavor of CRON expressions the parser accepts.
+// The dialect decides the layout of the fields, so it doesn't have to be guessed from the expression.
+type Dialect int
+
+const (
+	// DialectDefault accepts 5, 6 and 7 part CRON expressions of all the supported dialects.
+	// 6 part expressions have a year (last part) if the last part is a 4-digit year, a second (first part) otherwise.
+	DialectDefault Dialect = iota
+	// DialectPOSIX accepts 5 part CRON expressions (minute hour day-of-month month day-of-week).
+	DialectPOSIX
+	// DialectVixie accepts 5 part CRON expressions and the predefined macros except @every.
+	DialectVixie
+	// DialectQuartz accepts 6 or 7 part CRON expressions (second ... day-of-week [year]).
+	// Day of week is numbered from 1 (Sunday) to 7 (Saturday) and either day of month or day of week must be ?.
+	DialectQuartz
+	// DialectSpring accepts 6 part CRON expressions (second ... day-of-week) and the predefined macros
+	// except @reboot and @every.
+	DialectSpring
+	// DialectEventBridge accepts the 6 part CRON expressions (minute ... day-of-week year) of the AWS EventBridge
+	// schedules, optionally wrapped in cron(), and the rate() expressions.
+	// Day of week is numbered from 1 (Sunday) to 7 (Saturday) and either day of month or day of week must be ?.
+	DialectEventBridge
+)
+
+var (
+	dialectNames = map[Dialect]string{
+		DialectDefault:     "default",
+		DialectPOSIX:       "POSIX",
+		DialectVixie:       "Vixie",
+		DialectQuartz:      "Quartz",
+		DialectSpring:      "Spring",
+		DialectEventBridge: "EventBridge",
+	}
+
+	// rateUnits are the units of the EventBridge rate() expressions.
+	rateUnits = map[string]time.Duration{
+		"minute": time.Minute,
+		"hour":   time.Hour,
+		"day":    24 * time.Hour,
+	}
+)
+
+func (d Dialect) String() string {
+	if name, ok := dialectNames[d]; ok {
+		return name
+	}
+	return "Dialect(" + strconv.Itoa(int(d)) + ")"
+}
+
+// ParseDialect returns the dialect by its name (case insensitive), i.e. "quartz" or "EventBridge".
+func ParseDialect(s string) (Dialect, error) {
+	for dialect, name := range dialectNames {
+		if strings.EqualFold(s, name) {
+			return dialect, nil
+		}
+	}
+	return DialectDefault, fmt.Errorf("unknown dialect %s", s)
+}
+
+// isDOWStartsAtOne reports whether the dialect numbers the days of week from 1 (Sunday).
+func (d Dialect) isDOWStartsAtOne() bool {
+	return d == DialectQuartz || d == DialectEventBridge
+}
+
+// requiresQuestionMark reports whether either day of month or day of week must be ? in the dialect.
+func (d Dialect) requiresQuestionMark() bool {
+	return d == DialectQuartz || d == DialectEventBridge
+}
+
+// setQuestionMark sets the day of month or day of week part which is not specified to ?,
+// i.e. "* * 1" to "? * 1" and "1 * *" to "1 * ?".
+func setQuestionMark(parts *[7]string) {
+	if parts[FieldDayOfWeek] != "*" && parts[FieldDayOfMonth] == "*" {
+		parts[FieldDayOfMonth] = "?"
+	} else if parts[FieldDayOfWeek] == "*" {
+		parts[FieldDayOfWeek] = "?"
+	}
+}
+
+// supportsMacro reports whether the dialect supports the predefined macro (i.e. @daily, @reboot, @every).
+func (d Dialect) supportsMacro(macro string) bool {
+	switch d {
+	case DialectDefault:
+		return true
+	case DialectVixie:
+		return macro != "@every"
+	case DialectSpring:
+		return macro != "@reboot" && macro != "@every"
+	}
+	return false
+}
+
+// layouts returns the field layouts of the dialect, or nil if the layout is guessed from the expression.
+func (d Dialect) layouts() []FieldLayout {
+	switch d {
+	case DialectPOSIX, DialectVixie:
+		return []FieldLayout{LayoutFiveFields}
+	case DialectQuartz:
+		return []FieldLayout{LayoutSecondsFirst, LayoutSevenFields}
+	case DialectSpring:
+		return []FieldLayout{LayoutSecondsFirst}
+	case DialectEventBridge:
+		return []FieldLayout{LayoutYearLast}
+	}
+	return nil
+}
+
+// validateDialect checks the special characters of the day of month and day of week parts against the dialect.
+func (p *cronParser) validateDialect(tokens *exprTokens) error {
+	dom := strings.ToLower(tokens.parts[FieldDayOfMonth])
+	dow := strings.ToLower(tokens.parts[FieldDayOfWeek])
+
+	switch tokens.dialect {
+	case DialectPOSIX, DialectVixie:
+		if strings.ContainsAny(dom, "lw#?") {
+			return tokens.errorAt(FieldDayOfMonth, -1, fieldBounds[FieldDayOfMonth],
+				fmt.Sprintf("L, W, # and ? are not supported by the %s dialect", tokens.dialect), InvalidExprDayOfMonthError)
+		}
+		for k := range days {
+			dow = strings.Replace(dow, k, "", -1) // wed contains w
+		}
+		if strings.ContainsAny(dow, "lw#?") {
+			return tokens.errorAt(FieldDayOfWeek, -1, p.dayOfWeekBounds(),
+				fmt.Sprintf("L, W, # and ? are not supported by the %s dialect", tokens.dialect), InvalidExprDayOfWeekError)
+		}
+	case DialectQuartz, DialectEventBridge:
+		if (dom == "?") == (dow == "?") {
+			return tokens.errorAt(FieldDayOfWeek, -1, p.dayOfWeekBounds(),
+				fmt.Sprintf("either day of month or day of week must be ? in the %s dialect", tokens.dialect), InvalidExprDayOfWeekError)
+		}
+	}
+	return nil
+}
+
+// unwrapExpr removes the cron() wrapper of the EventBridge CRON expressions, and returns the dialect of expr.
+// The wrapper is replaced by spaces, so the offsets of the parts are kept.
+func (p *cronParser) unwrapExpr(expr string) (string, Dialect, error) {
+	trimmed := strings.TrimSpace(expr)
+	if len(trimmed) < 5 || !strings.EqualFold(trimmed[:5], "cron(") {
+		return expr, p.dialect, nil
+	}
+
+	if p.dialect != DialectDefault && p.dialect != DialectEventBridge {
+		return "", p.dialect, fmt.Errorf("cron() is not supported by the %s dialect: %w", p.dialect, InvalidExprError)
+	}
+	if !strings.HasSuffix(trimmed, ")") {
+		return "", p.dialect, fmt.Errorf("cron( must be closed by ): %w", InvalidExprError)
+	}
+	start := strings.Index(expr, trimmed)
+	end := start + len(trimmed) - 1
+	return expr[:start] + "     " + expr[start+5:end] + " " + expr[end+1:], DialectEventBridge, nil
+}
+
+// parseRate parses the EventBridge rate(<value> <unit>) expression.
+func (p *cronParser) parseRate(expr string) (special *specialExpr, err error) {
+	if p.dialect != DialectDefault && p.dialect != DialectEventBridge {
+		return nil, fmt.Errorf("rate() is not supported by the %s dialect: %w", p.dialect, InvalidExprError)
+	}
+
+	expr = strings.ToLower(strings.TrimSpace(expr))
+	if !strings.HasSuffix(expr, ")") {
+		return nil, fmt.Errorf("rate( must be closed by ): %w", InvalidExprError)
+	}
+	parts := strings.Fields(expr[len("rate(") : len(expr)-1])
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("rate() must have a value and a unit, i.e. rate(5 minutes): %w", InvalidExprError)
+	}
+
+	value, err := strconv.Atoi(parts[0])
+	if err != nil || value <= 0 {
+		return nil, fmt.Errorf("rate() value must be a positive integer, got %s: %w", parts[0], InvalidExprError)
+	}
+	unit := strings.TrimSuffix(parts[1], "s")
+	duration, ok := rateUnits[unit]
+	if !ok {
+		return nil, fmt.Errorf("rate() unit must be minute(s), hour(s) or day(s), got %s: %w", parts[1], InvalidExprError)
+	}
+	// As in EventBridge, the unit is singular for 1 and plural otherwise
+	if (value == 1) != (parts[1] == unit) {
+		return nil, fmt.Errorf("rate() unit must be singular only if the value is 1, got %d %s: %w", value, parts[1], InvalidExprError)
+	}
+	return &specialExpr{every: time.Duration(value) * duration}, nil
+}
+
+// dialectParser returns the parser of the dialect the expression is written in.
+func (p *cronParser) dialectParser(dialect Dialect) *cronParser {
+	if dialect == p.dialect {
+		return p
+	}
+	parser := *p
+	parser.dialect = dialect
+	parser.isDOWStartsAtOne = p.isDOWStartsAtOne || dialect.isDOWStartsAtOne()
+	return &parser
+}
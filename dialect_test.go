@@ -0,0 +1,130 @@
+package cron
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestCronParser_Dialect(t *testing.T) {
+	type testCase struct {
+		name      string
+		inDialect Dialect
+		inExpr    string
+		outExprs  []string
+		outErr    error
+	}
+
+	tcs := []testCase{
+		{name: "should guess second with default dialect", inDialect: DialectDefault, inExpr: "0 12 * ? * *", outExprs: []string{"", "12", "*", "*", "*", "*", ""}},
+		{name: "should unwrap EventBridge expression with default dialect", inDialect: DialectDefault, inExpr: "cron(0 12 * * ? *)", outExprs: []string{"", "0", "12", "*", "*", "*", "*"}},
+		{name: "should place year last with EventBridge", inDialect: DialectEventBridge, inExpr: "0 12 ? * * *", outExprs: []string{"", "0", "12", "*", "*", "*", "*"}},
+		{name: "should unwrap EventBridge expression", inDialect: DialectEventBridge, inExpr: " cron(0/15 * ? * MON-FRI *) ", outExprs: []string{"", "*/15", "*", "*", "*", "1-5", "*"}},
+		{name: "should number EventBridge day of week from Sunday", inDialect: DialectEventBridge, inExpr: "0 12 ? * 2 2030", outExprs: []string{"", "0", "12", "*", "*", "1", "2030"}},
+		{name: "should fail on EventBridge without ?", inDialect: DialectEventBridge, inExpr: "0 12 * * * *", outErr: InvalidExprDayOfWeekError},
+		{name: "should fail on EventBridge with both ?", inDialect: DialectEventBridge, inExpr: "0 12 ? * ? *", outErr: InvalidExprDayOfWeekError},
+		{name: "should fail on EventBridge with 5 parts", inDialect: DialectEventBridge, inExpr: "0 12 ? * *", outErr: InvalidExprError},
+		{name: "should fail on rate() as CRON expression", inDialect: DialectEventBridge, inExpr: "rate(5 minutes)", outErr: InvalidExprError},
+		{name: "should parse Quartz with second", inDialect: DialectQuartz, inExpr: "0 0 12 ? * 1", outExprs: []string{"", "0", "12", "*", "*", "0", ""}},
+		{name: "should parse Quartz with year", inDialect: DialectQuartz, inExpr: "0 0 12 ? * 1 2030", outExprs: []string{"", "0", "12", "*", "*", "0", "2030"}},
+		{name: "should fail on Quartz with 5 parts", inDialect: DialectQuartz, inExpr: "0 12 ? * 1", outErr: InvalidExprError},
+		{name: "should fail on Quartz without ?", inDialect: DialectQuartz, inExpr: "0 0 12 * * 1", outErr: InvalidExprDayOfWeekError},
+		{name: "should parse Spring", inDialect: DialectSpring, inExpr: "0 0 12 * * MON-FRI", outExprs: []string{"", "0", "12", "*", "*", "1-5", ""}},
+		{name: "should parse Spring macro", inDialect: DialectSpring, inExpr: "@weekly", outExprs: []string{"", "0", "0", "*", "*", "0", ""}},
+		{name: "should fail on Spring with 7 parts", inDialect: DialectSpring, inExpr: "0 0 12 * * MON 2030", outErr: InvalidExprError},
+		{name: "should fail on cron() with Spring", inDialect: DialectSpring, inExpr: "cron(0 0 12 * * MON)", outErr: InvalidExprError},
+		{name: "should parse POSIX", inDialect: DialectPOSIX, inExpr: "0 12 * * wed", outExprs: []string{"", "0", "12", "*", "*", "3", ""}},
+		{name: "should fail on POSIX with 6 parts", inDialect: DialectPOSIX, inExpr: "0 0 12 * * wed", outErr: InvalidExprError},
+		{name: "should fail on POSIX with L", inDialect: DialectPOSIX, inExpr: "0 12 L * *", outErr: InvalidExprDayOfMonthError},
+		{name: "should fail on POSIX with macro", inDialect: DialectPOSIX, inExpr: "@daily", outErr: InvalidExprError},
+		{name: "should fail on Vixie with #", inDialect: DialectVixie, inExpr: "0 12 * * 5#3", outErr: InvalidExprDayOfWeekError},
+		{name: "should parse Vixie macro", inDialect: DialectVixie, inExpr: "@daily", outExprs: []string{"", "0", "0", "*", "*", "*", ""}},
+	}
+
+	for i, tc := range tcs {
+		parser := NewParser(SetDialect(tc.inDialect))
+
+		parsed, err := parser.Parse(tc.inExpr)
+		if tc.outErr != nil {
+			if !errors.Is(err, tc.outErr) {
+				t.Errorf("%d. %s: expected '%v' error, got '%v'", i, tc.name, tc.outErr, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%d. %s: expected nil, got error '%v'", i, tc.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(parsed, tc.outExprs) {
+			t.Errorf("%d. %s: expected '%v', got '%v'", i, tc.name, tc.outExprs, parsed)
+		}
+	}
+}
+
+func TestExpressionDescriptor_Dialect(t *testing.T) {
+	type testCase struct {
+		name      string
+		inDialect Dialect
+		inExpr    string
+		outDesc   string
+		outErr    error
+	}
+
+	tcs := []testCase{
+		{name: "EventBridge cron", inDialect: DialectEventBridge, inExpr: "cron(0/15 * ? * MON-FRI *)", outDesc: "Every 15 minutes, Monday through Friday"},
+		{name: "EventBridge year", inDialect: DialectEventBridge, inExpr: "0 12 ? * 2 2030", outDesc: "At 12:00 PM, only on Monday, only in 2030"},
+		{name: "EventBridge rate minutes", inDialect: DialectEventBridge, inExpr: "rate(5 minutes)", outDesc: "Every 5 minutes"},
+		{name: "EventBridge rate minute", inDialect: DialectEventBridge, inExpr: "rate(1 minute)", outDesc: "Every minute"},
+		{name: "EventBridge rate hours", inDialect: DialectEventBridge, inExpr: "rate(12 hours)", outDesc: "Every 12 hours"},
+		{name: "EventBridge rate day", inDialect: DialectEventBridge, inExpr: "rate(1 day)", outDesc: "Every day"},
+		{name: "EventBridge rate days", inDialect: DialectEventBridge, inExpr: "RATE(7 Days)", outDesc: "Every 7 days"},
+		{name: "default rate", inDialect: DialectDefault, inExpr: "rate(1 hour)", outDesc: "Every hour"},
+		{name: "rate with plural unit of 1", inDialect: DialectEventBridge, inExpr: "rate(1 days)", outErr: InvalidExprError},
+		{name: "rate with singular unit", inDialect: DialectEventBridge, inExpr: "rate(5 minute)", outErr: InvalidExprError},
+		{name: "rate with zero value", inDialect: DialectEventBridge, inExpr: "rate(0 minutes)", outErr: InvalidExprError},
+		{name: "rate with unknown unit", inDialect: DialectEventBridge, inExpr: "rate(5 seconds)", outErr: InvalidExprError},
+		{name: "rate not closed", inDialect: DialectEventBridge, inExpr: "rate(5 minutes", outErr: InvalidExprError},
+		{name: "rate with Quartz", inDialect: DialectQuartz, inExpr: "rate(5 minutes)", outErr: InvalidExprError},
+		{name: "Quartz", inDialect: DialectQuartz, inExpr: "0 15 10 ? * 6L 2002-2005", outDesc: "At 10:15 AM, on the last Friday of the month, 2002 through 2005"},
+		{name: "Vixie reboot", inDialect: DialectVixie, inExpr: "@reboot", outDesc: "At system startup"},
+		{name: "Spring reboot", inDialect: DialectSpring, inExpr: "@reboot", outErr: InvalidExprError},
+	}
+
+	for i, tc := range tcs {
+		exprDesc, err := NewDescriptor(SetDialect(tc.inDialect))
+		if err != nil {
+			t.Errorf("failed to create expression descriptor: %s", err)
+			return
+		}
+
+		gotDesc, err := exprDesc.ToDescription(tc.inExpr, Locale_en)
+		if tc.outErr != nil {
+			if !errors.Is(err, tc.outErr) {
+				t.Errorf("%d. %s: expected '%v' error, got '%v'", i, tc.name, tc.outErr, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%d. %s: expected nil, got error '%v'", i, tc.name, err)
+			continue
+		}
+		if gotDesc != tc.outDesc {
+			t.Errorf("%d. %s: expected '%v', got '%v'", i, tc.name, tc.outDesc, gotDesc)
+		}
+	}
+}
+
+func TestParseDialect(t *testing.T) {
+	for dialect, name := range dialectNames {
+		got, err := ParseDialect(name)
+		if err != nil || got != dialect {
+			t.Errorf("%s: expected %v, got %v (%v)", name, dialect, got, err)
+		}
+	}
+	if got, err := ParseDialect("eventbridge"); err != nil || got != DialectEventBridge {
+		t.Errorf("eventbridge: expected %v, got %v (%v)", DialectEventBridge, got, err)
+	}
+	if _, err := ParseDialect("fcron"); err == nil {
+		t.Errorf("fcron: expected error, got nil")
+	}
+}
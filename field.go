@@ -0,0 +1,511 @@
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	FieldSecond FieldType = iota
+	FieldMinute
+	FieldHour
+	FieldDayOfMonth
+	FieldMonth
+	FieldDayOfWeek
+	FieldYear
+)
+
+type (
+	// FieldType is the unit of a CRON expression field.
+	// The value of FieldType is the index of the field in the normalized 7-part CRON expression.
+	FieldType int
+
+	// Field is a single field of a CRON expression.
+	// The field matches a value if any of its items matches it.
+	// A field without items is not specified (i.e. second is 0 and year is every year).
+	Field struct {
+		Type  FieldType
+		Items []Item
+	}
+
+	// Item is a single comma separated item of a CRON expression field.
+	// The concrete type of an Item is one of Any, Value, Range, Step, Last, LastWeekday,
+	// NearestWeekday, NthWeekday or LastOffset.
+	Item interface {
+		String() string
+		isItem()
+	}
+
+	// Any is '*', which matches every value of the field.
+	Any struct{}
+
+	// Value matches a single value (i.e. 5).
+	// Months are from 1 (January) to 12 (December) and days of week are from 0 (Sunday) to 6 (Saturday).
+	Value struct {
+		Value int
+	}
+
+	// Range matches the values from From to To inclusively (i.e. 1-5).
+	// In the day of month field, ToLast makes the range end at the last day of the month (i.e. 20-L).
+	// In the day of week field, the range can wrap around the end of the week (i.e. 5-0 is Friday through Sunday).
+	Range struct {
+		From   int
+		To     int
+		ToLast bool
+	}
+
+	// Step matches every Interval values, starting from Base.
+	// Base is Any (i.e. */5), Value (i.e. 5/10) or Range (i.e. 1-30/5).
+	Step struct {
+		Base     Item
+		Interval int
+	}
+
+	// Last is 'L'. In the day of month field it's the last day of the month.
+	// In the day of week field it's the last Weekday of the month (i.e. 5L is the last Friday of the month).
+	Last struct {
+		Weekday    int
+		HasWeekday bool
+	}
+
+	// LastWeekday is 'LW', the last weekday (Monday to Friday) of the month.
+	LastWeekday struct{}
+
+	// NearestWeekday is 'W', the weekday (Monday to Friday) nearest to Day of the month (i.e. 15W).
+	NearestWeekday struct {
+		Day int
+	}
+
+	// NthWeekday is '#', the Nth Weekday of the month (i.e. 5#3 is the third Friday of the month).
+	NthWeekday struct {
+		Weekday int
+		N       int
+	}
+
+	// LastOffset is 'L-n', Offset days before the last day of the month (i.e. L-3).
+	LastOffset struct {
+		Offset int
+	}
+)
+
+// fieldErrors holds the error of each field type.
+var fieldErrors = [7]error{
+	InvalidExprSecondError,
+	InvalidExprMinuteError,
+	InvalidExprHourError,
+	InvalidExprDayOfMonthError,
+	InvalidExprMonthError,
+	InvalidExprDayOfWeekError,
+	InvalidExprYearError,
+}
+
+// fieldBounds holds the lower and upper bound of each field type.
+var fieldBounds = [7][2]int{
+	{0, 59},                            // Second
+	{0, 59},                            // Minute
+	{0, 23},                            // Hour
+	{1, 31},                            // Day of month
+	{1, 12},                            // Month
+	{0, 6},                             // Day of week
+	{minScheduleYear, maxScheduleYear}, // Year
+}
+
+func (Any) isItem()            {}
+func (Value) isItem()          {}
+func (Range) isItem()          {}
+func (Step) isItem()           {}
+func (Last) isItem()           {}
+func (LastWeekday) isItem()    {}
+func (NearestWeekday) isItem() {}
+func (NthWeekday) isItem()     {}
+func (LastOffset) isItem()     {}
+
+func (Any) String() string {
+	return "*"
+}
+
+func (v Value) String() string {
+	return strconv.Itoa(v.Value)
+}
+
+func (r Range) String() string {
+	if r.ToLast {
+		return strconv.Itoa(r.From) + "-L"
+	}
+	return strconv.Itoa(r.From) + "-" + strconv.Itoa(r.To)
+}
+
+func (s Step) String() string {
+	base := "*"
+	if s.Base != nil {
+		base = s.Base.String()
+	}
+	return base + "/" + strconv.Itoa(s.Interval)
+}
+
+func (l Last) String() string {
+	if l.HasWeekday {
+		return strconv.Itoa(l.Weekday) + "L"
+	}
+	return "L"
+}
+
+func (LastWeekday) String() string {
+	return "LW"
+}
+
+func (n NearestWeekday) String() string {
+	return strconv.Itoa(n.Day) + "W"
+}
+
+func (n NthWeekday) String() string {
+	return strconv.Itoa(n.Weekday) + "#" + strconv.Itoa(n.N)
+}
+
+func (l LastOffset) String() string {
+	return "L-" + strconv.Itoa(l.Offset)
+}
+
+// oneBasedWeekday returns the day of week item numbered from 1 (Sunday) to 7 (Saturday).
+func oneBasedWeekday(item Item) Item {
+	switch it := item.(type) {
+	case Value:
+		it.Value++
+		return it
+	case Range:
+		it.From, it.To = it.From+1, it.To+1
+		return it
+	case Step:
+		it.Base = oneBasedWeekday(it.Base)
+		return it
+	case Last:
+		if it.HasWeekday {
+			it.Weekday++
+		}
+		return it
+	case NthWeekday:
+		it.Weekday++
+		return it
+	}
+	return item
+}
+
+func (t FieldType) String() string {
+	switch t {
+	case FieldSecond:
+		return "second"
+	case FieldMinute:
+		return "minute"
+	case FieldHour:
+		return "hour"
+	case FieldDayOfMonth:
+		return "day of month"
+	case FieldMonth:
+		return "month"
+	case FieldDayOfWeek:
+		return "day of week"
+	case FieldYear:
+		return "year"
+	}
+	return "unknown"
+}
+
+// String returns the canonical CRON representation of the field.
+func (f Field) String() string {
+	items := make([]string, 0, len(f.Items))
+	for _, item := range f.Items {
+		if r, ok := item.(Range); ok && !r.ToLast && r.From == r.To {
+			item = Value{Value: r.From} // i.e. the hour of "*/5 9 * * *"; the base of a step is kept, as 9/2 isn't 9-9/2
+		}
+		items = append(items, item.String())
+	}
+	return strings.Join(items, ",")
+}
+
+// parseField parses a normalized CRON expression part into the field.
+func parseField(typ FieldType, part string) (field Field, err error) {
+	field = Field{Type: typ}
+	if part == "" {
+		return field, nil
+	}
+
+	for _, token := range strings.Split(part, ",") {
+		item, err := parseItem(typ, token)
+		if err != nil {
+			return Field{}, err
+		}
+		if r, ok := item.(Range); ok && !r.ToLast && r.From == r.To {
+			item = Value{Value: r.From} // Same as written, so the fields of "*/5 9 * * *" and "*/5 9-9 * * *" are equal
+		}
+		field.Items = append(field.Items, item)
+	}
+	return field, nil
+}
+
+func parseItem(typ FieldType, token string) (item Item, err error) {
+	invalid := func() (Item, error) {
+		return nil, fmt.Errorf("%s contains invalid item %q: %w", typ, token, fieldErrors[typ])
+	}
+
+	if token == "*" {
+		return Any{}, nil
+	}
+
+	if idx := strings.Index(token, "/"); idx > -1 {
+		base, err := parseItem(typ, token[:idx])
+		if err != nil {
+			return invalid()
+		}
+		switch base.(type) {
+		case Any, Value, Range:
+		default:
+			return invalid()
+		}
+		interval, err := parseDigits(token[idx+1:])
+		if err != nil || interval <= 0 {
+			return invalid()
+		}
+		return Step{Base: base, Interval: interval}, nil
+	}
+
+	switch typ {
+	case FieldDayOfMonth:
+		switch {
+		case token == "l":
+			return Last{}, nil
+		case token == "lw" || token == "wl":
+			return LastWeekday{}, nil
+		case strings.HasPrefix(token, "l-"):
+			offset, err := parseDigits(token[2:])
+			if err != nil {
+				return invalid()
+			}
+			return LastOffset{Offset: offset}, nil
+		case strings.HasPrefix(token, "w") || strings.HasSuffix(token, "w"):
+			day, err := parseDigits(strings.Trim(token, "w"))
+			if err != nil {
+				return invalid()
+			}
+			return NearestWeekday{Day: day}, nil
+		case strings.HasSuffix(token, "-l"):
+			from, err := parseDigits(strings.TrimSuffix(token, "-l"))
+			if err != nil {
+				return invalid()
+			}
+			return Range{From: from, ToLast: true}, nil
+		}
+	case FieldDayOfWeek:
+		if idx := strings.Index(token, "#"); idx > -1 {
+			weekday, err1 := parseDigits(token[:idx])
+			n, err2 := parseDigits(token[idx+1:])
+			if err1 != nil || err2 != nil {
+				return invalid()
+			}
+			return NthWeekday{Weekday: weekday, N: n}, nil
+		}
+		if strings.HasSuffix(token, "l") {
+			weekday, err := parseDigits(strings.TrimSuffix(token, "l"))
+			if err != nil {
+				return invalid()
+			}
+			return Last{Weekday: weekday, HasWeekday: true}, nil
+		}
+	}
+
+	if idx := strings.Index(token, "-"); idx > -1 {
+		from, err1 := parseDigits(token[:idx])
+		to, err2 := parseDigits(token[idx+1:])
+		if err1 != nil || err2 != nil {
+			return invalid()
+		}
+		return Range{From: from, To: to}, nil
+	}
+
+	value, err := parseDigits(token)
+	if err != nil {
+		return invalid()
+	}
+	return Value{Value: value}, nil
+}
+
+// parseDigits parses the unsigned decimal number, unlike strconv.Atoi which also accepts the signs (i.e. "-1").
+func parseDigits(s string) (int, error) {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return 0, strconv.ErrSyntax
+		}
+	}
+	return strconv.Atoi(s)
+}
+
+// matches reports whether the value is matched by the field.
+// upperBound is the largest value of the field (i.e. the last day of the month for day of month).
+func (f Field) matches(value, upperBound int) bool {
+	if len(f.Items) == 0 {
+		return true
+	}
+	for _, item := range f.Items {
+		if f.matchesItem(item, value, upperBound) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f Field) matchesItem(item Item, value, upperBound int) bool {
+	switch it := item.(type) {
+	case Any:
+		return true
+	case Value:
+		return value == it.Value
+	case Range:
+		if it.ToLast {
+			return value >= it.From && value <= upperBound
+		}
+		return f.inRange(value, it.From, it.To)
+	case Step:
+		from, to := fieldBounds[f.Type][0], upperBound
+		switch base := it.Base.(type) {
+		case Value:
+			from = base.Value
+		case Range:
+			from, to = base.From, base.To
+			if base.ToLast {
+				to = upperBound
+			}
+		}
+		if !f.inRange(value, from, to) {
+			return false
+		}
+		if value < from { // Wrapped day of week range
+			value += 7
+		}
+		return (value-from)%it.Interval == 0
+	}
+	return false
+}
+
+// matchesDayOfMonth reports whether the day of month field matches the day.
+func (f Field) matchesDayOfMonth(year, month, day, lastDay int) bool {
+	if len(f.Items) == 0 {
+		return true
+	}
+	for _, item := range f.Items {
+		switch it := item.(type) {
+		case Last:
+			if day == lastDay {
+				return true
+			}
+		case LastWeekday:
+			if day == lastWeekdayOfMonth(year, month, lastDay) {
+				return true
+			}
+		case LastOffset:
+			if day == lastDay-it.Offset {
+				return true
+			}
+		case NearestWeekday:
+			if it.Day <= lastDay && day == nearestWeekday(year, month, it.Day, lastDay) {
+				return true
+			}
+		default:
+			if f.matchesItem(item, day, lastDay) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matchesDayOfWeek reports whether the day of week field matches the day.
+func (f Field) matchesDayOfWeek(day, weekday, lastDay int) bool {
+	if len(f.Items) == 0 {
+		return true
+	}
+	for _, item := range f.Items {
+		switch it := item.(type) {
+		case Last:
+			if weekday == it.Weekday && day+7 > lastDay {
+				return true
+			}
+		case NthWeekday:
+			if weekday == it.Weekday && (day-1)/7+1 == it.N {
+				return true
+			}
+		default:
+			if f.matchesItem(item, weekday, 6) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// values returns the ascending list of values matched by the field.
+func (f Field) values() []int {
+	if len(f.Items) == 0 {
+		return []int{0} // Second is not specified
+	}
+	lower, upper := fieldBounds[f.Type][0], fieldBounds[f.Type][1]
+	values := make([]int, 0, upper-lower+1)
+	for v := lower; v <= upper; v++ {
+		if f.matches(v, upper) {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// isStar reports whether the field starts with '*'.
+func (f Field) isStar() bool {
+	if len(f.Items) == 0 {
+		return true
+	}
+	switch it := f.Items[0].(type) {
+	case Any:
+		return true
+	case Step:
+		_, ok := it.Base.(Any)
+		return ok
+	}
+	return false
+}
+
+// inRange reports whether value is in range [from, to].
+// A day of week range can wrap around the end of the week, since 7 (Sunday) is normalized to 0 (i.e. 5-0).
+func (f Field) inRange(value, from, to int) bool {
+	if f.Type == FieldDayOfWeek && from > to {
+		return value >= from || value <= to
+	}
+	return value >= from && value <= to
+}
+
+func lastWeekdayOfMonth(year, month, lastDay int) int {
+	switch time.Date(year, time.Month(month), lastDay, 0, 0, 0, 0, time.UTC).Weekday() {
+	case time.Saturday:
+		return lastDay - 1
+	case time.Sunday:
+		return lastDay - 2
+	}
+	return lastDay
+}
+
+// nearestWeekday returns the weekday (Monday to Friday) nearest to the day, without crossing
+// the month boundaries.
+func nearestWeekday(year, month, day, lastDay int) int {
+	switch time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC).Weekday() {
+	case time.Saturday:
+		if day == 1 {
+			return day + 2
+		}
+		return day - 1
+	case time.Sunday:
+		if day == lastDay {
+			return day - 2
+		}
+		return day + 1
+	}
+	return day
+}
@@ -0,0 +1,740 @@
+package cron
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	InvalidDescriptionError = errors.New("invalid description")
+
+	whitespaceRegex = regexp.MustCompile(`\s+`)
+)
+
+type (
+	// descParser matches the clauses of a description (i.e. "every 5 minutes", "Monday through Friday")
+	// against the strings of a locale, to convert the description back to a CRON expression.
+	descParser struct {
+		locale           Locale
+		isDOWStartsAtOne bool
+		clauses          []descClause
+
+		// Patterns of the values of the placeholders
+		num, time, day, ordinal, dayOfWeek, month, year string
+		// sep is the pattern of the separators of the items of a list, i.e. ", " and " and "
+		sep *regexp.Regexp
+	}
+
+	// descClause is a string of the locale which describes a field of the CRON expression.
+	descClause struct {
+		regex *regexp.Regexp
+		apply func(s *descState, values []string) bool
+	}
+
+	// descState holds the fields of the CRON expression which have been described so far.
+	descState struct {
+		fields [7]string
+	}
+)
+
+// FromDescription converts the human readable description in the specified locale back to a CRON expression,
+// the inverse of ToDescription, i.e. "Every 5 minutes" to "*/5 * * * *" and "At 09:00 AM, Monday through Friday"
+// to "0 9 * * 1-5".
+// The description is matched against the strings of the locale, so the descriptions output by ToDescription
+// convert back to an expression which has the same description. The time of day defaults to 12:00 AM,
+// i.e. "on the last weekday of the month" is "0 0 LW * *".
+// The time zones, the hashed values (H) and the literal text of the TimeFormat layouts are not supported.
+func (e *ExpressionDescriptor) FromDescription(text string, loc LocaleType) (expr string, err error) {
+	normalized := strings.TrimRight(whitespaceRegex.ReplaceAllString(strings.TrimSpace(text), " "), ".")
+	if normalized == "" {
+		return "", fmt.Errorf("failed to parse description: %w", InvalidDescriptionError)
+	}
+
+	p := e.getDescParser(e.getLocale(loc))
+	expr, state, ok := p.parseSpecial(normalized)
+	if !ok {
+		if state, ok = p.parse(normalized, descState{}); !ok {
+			return "", fmt.Errorf("failed to parse description '%s': %w", text, InvalidDescriptionError)
+		}
+	}
+	if expr == "" {
+		expr = state.format(e.descLayout(state), e.dialect.requiresQuestionMark())
+	}
+
+	special, err := e.parser.parseSpecial(expr)
+	if err == nil && special == nil {
+		_, _, err = e.parser.parse(expr)
+	}
+	if err != nil {
+		return "", fmt.Errorf("description '%s' is not a valid CRON expression '%s': %w", text, expr, err)
+	}
+	return expr, nil
+}
+
+// getDescParser returns the parser of the descriptions in the locale, the regular expressions of its clauses
+// are compiled once per locale.
+func (e *ExpressionDescriptor) getDescParser(locale Locale) *descParser {
+	if p, ok := e.descParsers.Load(locale.GetLocaleType()); ok {
+		return p.(*descParser)
+	}
+	p, _ := e.descParsers.LoadOrStore(locale.GetLocaleType(), e.newDescParser(locale))
+	return p.(*descParser)
+}
+
+// parse matches the clauses at the start of the text, backtracking until the whole text is matched.
+func (p *descParser) parse(text string, state descState) (descState, bool) {
+	if text == "" {
+		return state, state != descState{}
+	}
+	for _, clause := range p.clauses {
+		matches := clause.regex.FindStringSubmatch(text)
+		if matches == nil {
+			continue
+		}
+		next := state
+		if !clause.apply(&next, matches[1:]) {
+			continue
+		}
+		if result, ok := p.parse(strings.TrimSpace(text[len(matches[0]):]), next); ok {
+			return result, true
+		}
+	}
+	return state, false
+}
+
+// parseSpecial returns the @reboot or @every expression of the description which has no time fields
+// (see getSpecialDescription), or the fields of the interval which can be written with the time fields,
+// i.e. "Every 2 hours" is "0 */2 * * *" but "Every 90 minutes" is "@every 90m".
+func (p *descParser) parseSpecial(text string) (expr string, state descState, ok bool) {
+	if p.matchesWhole(p.locale.GetString(atSystemStartup), text) != nil {
+		return "@reboot", state, true
+	}
+
+	var every time.Duration
+	if p.matchesWhole(p.locale.GetString(everyDay), text) != nil {
+		every = 24 * time.Hour
+	}
+	intervals := []struct {
+		key  LocaleKey
+		unit time.Duration
+		min  int
+	}{
+		{key: everyX0Days, unit: 24 * time.Hour, min: 1},
+		{key: everyX0Hours, unit: time.Hour, min: 1},
+		{key: everyX0Minutes, unit: time.Minute, min: 60}, // The shorter intervals are parsed as the minute field
+		{key: everyX0Seconds, unit: time.Second, min: 60},
+	}
+search:
+	for _, interval := range intervals {
+		for _, form := range pluralForms(p.locale, interval.key) {
+			if every > 0 {
+				break search
+			}
+			values := p.matchesWhole(form, text, p.num)
+			if len(values) == 0 {
+				continue
+			}
+			if n, err := strconv.Atoi(values[0]); err == nil && n >= interval.min {
+				every = time.Duration(n) * interval.unit
+			}
+		}
+	}
+	if every == 0 {
+		return "", state, false
+	}
+
+	step := func(n time.Duration) string {
+		if n == 1 {
+			return "*"
+		}
+		return "*/" + strconv.Itoa(int(n))
+	}
+	switch {
+	case every%(24*time.Hour) == 0 && every/(24*time.Hour) <= 31:
+		state.fields[FieldMinute], state.fields[FieldHour] = "0", "0"
+		state.fields[FieldDayOfMonth] = step(every / (24 * time.Hour))
+	case every%time.Hour == 0 && every < 24*time.Hour:
+		state.fields[FieldMinute], state.fields[FieldHour] = "0", step(every/time.Hour)
+	case every%time.Minute == 0 && every < time.Hour:
+		state.fields[FieldMinute] = step(every / time.Minute)
+	case every%time.Hour == 0:
+		return "@every " + strconv.Itoa(int(every/time.Hour)) + "h", state, true
+	case every%time.Minute == 0:
+		return "@every " + strconv.Itoa(int(every/time.Minute)) + "m", state, true
+	default:
+		return "@every " + strconv.Itoa(int(every/time.Second)) + "s", state, true
+	}
+	return "", state, true
+}
+
+// matchesWhole returns the values of the placeholders if the template matches the whole text, nil otherwise.
+func (p *descParser) matchesWhole(tmpl, text string, patterns ...string) []string {
+	if strings.TrimSpace(tmpl) == "" {
+		return nil
+	}
+	regex, err := regexp.Compile(`(?i)^` + templatePattern(tmpl, true, patterns...) + `$`)
+	if err != nil {
+		return nil
+	}
+	if matches := regex.FindStringSubmatch(text); matches != nil {
+		return append(matches[1:], "")
+	}
+	return nil
+}
+
+func (e *ExpressionDescriptor) newDescParser(locale Locale) *descParser {
+	p := &descParser{
+		locale:           locale,
+		isDOWStartsAtOne: e.isDOWStartsAtOne || e.dialect.isDOWStartsAtOne(),
+		num:              `\d+`,
+		year:             `\d{4}`,
+	}
+	am, pm := getPeriod(0, locale), getPeriod(12, locale)
+	period := alternation([]string{am, pm})
+	p.time = `(?:` + period + ` ?)?\d{1,2}(?::\d{2}){0,2}(?: ?` + period + `)?`
+	if locale.GetString(noon) != "" || locale.GetString(midnight) != "" { // See TimeFormat
+		p.time = alternation([]string{locale.GetString(noon), locale.GetString(midnight)}) + `|` + p.time
+	}
+	p.day = `\d+|` + regexp.QuoteMeta(locale.GetString(lastDay))
+	if dayFormat := locale.GetString(dayX0); dayFormat != "" {
+		p.day = templatePattern(dayFormat, false, `\d+`) + `|` + p.day
+	}
+	p.dayOfWeek = alternation(locale.GetSlice(daysOfTheWeek))
+	p.month = alternation(locale.GetSlice(monthsOfTheYear))
+	if locale.GetOrdinal(1) != "" {
+		ordinals := make([]string, 0, 31)
+		for n := 1; n <= 31; n++ {
+			ordinals = append(ordinals, locale.GetOrdinal(n))
+		}
+		p.ordinal = alternation(ordinals)
+	}
+	and := regexp.QuoteMeta(strings.TrimSpace(locale.GetString(spaceAnd)))
+	p.sep = regexp.MustCompile(`(?i)^(?:, ?` + and + ` |, | ` + and + ` )`)
+
+	p.addTimeOfDayClauses()
+	p.addDayOfMonthClauses()
+	p.addDayOfWeekClauses()
+	p.addMonthClauses()
+	p.addYearClauses()
+	return p
+}
+
+func (p *descParser) addTimeOfDayClauses() {
+	l := p.locale
+
+	// Specific time of day (i.e. "At 09:00 AM")
+	p.add(l.GetString(atSpace)+"%s", []string{p.time}, func(s *descState, values []string) bool {
+		hour, minute, second, hasSecond, ok := p.parseTime(values[0])
+		if !ok {
+			return false
+		}
+		if hasSecond && !s.set(FieldSecond, strconv.Itoa(second)) {
+			return false
+		}
+		return s.set(FieldMinute, strconv.Itoa(minute)) && s.set(FieldHour, strconv.Itoa(hour))
+	})
+	// Minute range in a single hour (i.e. "Every minute between 09:00 AM and 09:10 AM")
+	p.add(l.GetString(everyMinuteBetweenX0AndX1), []string{p.time, p.time}, func(s *descState, values []string) bool {
+		hour1, minute1, _, _, ok1 := p.parseTime(values[0])
+		hour2, minute2, _, _, ok2 := p.parseTime(values[1])
+		if !ok1 || !ok2 || hour1 != hour2 {
+			return false
+		}
+		return s.set(FieldMinute, strconv.Itoa(minute1)+"-"+strconv.Itoa(minute2)) && s.set(FieldHour, strconv.Itoa(hour1))
+	})
+	// Hours list with a single minute (i.e. "At 06:30 AM, 02:30 PM and 04:30 PM")
+	p.add(l.GetString(at)+" %s", []string{p.list(p.time)}, func(s *descState, values []string) bool {
+		minute := -1
+		var hours []string
+		for _, item := range p.split(values[0]) {
+			hour, m, _, _, ok := p.parseTime(item)
+			if !ok || (minute >= 0 && m != minute) {
+				return false
+			}
+			minute = m
+			hours = append(hours, strconv.Itoa(hour))
+		}
+		return len(hours) > 1 && s.set(FieldMinute, strconv.Itoa(minute)) && s.set(FieldHour, strings.Join(hours, ","))
+	})
+
+	p.addSegmentClauses(FieldSecond, p.num, p.parseNumber, segmentKeys{
+		all:      []LocaleKey{everySecond},
+		interval: everyX0Seconds,
+		between:  secondsX0ThroughX1PastTheMinute,
+		single:   []LocaleKey{atX0SecondsPastTheMinute, atX0SecondsPastTheMinuteGt20},
+	})
+	p.addSegmentClauses(FieldMinute, p.num, p.parseNumber, segmentKeys{
+		all:      []LocaleKey{everyMinute},
+		interval: everyX0Minutes,
+		between:  minutesX0ThroughX1PastTheHour,
+		single:   []LocaleKey{atX0MinutesPastTheHour, atX0MinutesPastTheHourGt20},
+	})
+	p.addSegmentClauses(FieldHour, p.time, p.parseHour, segmentKeys{
+		interval: everyX0Hours,
+		between:  betweenX0AndX1,
+		single:   []LocaleKey{atX0},
+	})
+
+	// "Every hour" is the minute 0 of every hour, or every hour after the minutes (i.e. "Every minute, every hour")
+	p.add(l.GetString(everyHour), nil, func(s *descState, values []string) bool {
+		if s.fields[FieldMinute] == "" && s.fields[FieldHour] == "" {
+			return s.set(FieldMinute, "0")
+		}
+		return s.set(FieldHour, "*")
+	})
+}
+
+func (p *descParser) addDayOfMonthClauses() {
+	l := p.locale
+
+	p.addLiteral(l.GetString(commaOnTheLastDayOfTheMonth), FieldDayOfMonth, "L")
+	p.addLiteral(l.GetString(commaOnTheLastWeekdayOfTheMonth), FieldDayOfMonth, "LW")
+	p.addLiteral(l.GetString(commaEveryDay), FieldDayOfMonth, "*")
+	if weekday := l.GetString(firstWeekday); weekday != "" {
+		p.addLiteral(sprintf(l.GetString(commaOnTheX0OfTheMonth), weekday), FieldDayOfMonth, "1W")
+	}
+	nearest := sprintf(l.GetString(commaOnTheX0OfTheMonth), l.GetString(weekdayNearestDayX0))
+	p.add(nearest, []string{p.num}, func(s *descState, values []string) bool {
+		return s.set(FieldDayOfMonth, values[0]+"W")
+	})
+	for _, form := range pluralForms(l, commaDaysBeforeTheLastDayOfTheMonth) {
+		p.add(form, []string{p.num}, func(s *descState, values []string) bool {
+			if values[0] == "" {
+				values[0] = "1"
+			}
+			return s.set(FieldDayOfMonth, "L-"+values[0])
+		})
+	}
+
+	// Days of the month in ordinal numbers (see UseOrdinals)
+	if p.ordinal != "" {
+		p.add(l.GetString(commaOnTheX0OfTheMonth), []string{p.list(p.ordinal)}, func(s *descState, values []string) bool {
+			value, ok := p.parseList(values[0], p.parseOrdinal)
+			return ok && s.set(FieldDayOfMonth, value)
+		})
+		p.add(l.GetString(commaOnTheX0ToLastDayOfTheMonth), []string{p.ordinal}, func(s *descState, values []string) bool {
+			n, ok := p.parseOrdinal(values[0])
+			return ok && n != "1" && s.set(FieldDayOfMonth, "L-"+strconv.Itoa(atoi(n)-1))
+		})
+	}
+
+	p.addSegmentClauses(FieldDayOfMonth, p.day, p.parseDay, segmentKeys{
+		interval: commaEveryX0Days,
+		between:  commaBetweenDayX0AndX1OfTheMonth,
+		single:   []LocaleKey{commaOnDayX0OfTheMonth},
+	})
+}
+
+func (p *descParser) addDayOfWeekClauses() {
+	l := p.locale
+
+	for _, key := range []LocaleKey{commaOnlyOnX0, commaAndOnX0} {
+		p.add(l.GetString(key), []string{p.list(p.dayOfWeek)}, func(s *descState, values []string) bool {
+			value, ok := p.parseList(values[0], p.parseDayOfWeek)
+			return ok && s.set(FieldDayOfWeek, value)
+		})
+	}
+	p.addRangeClause(FieldDayOfWeek, l.GetString(commaX0ThroughX1), p.dayOfWeek, p.parseDayOfWeek)
+	p.add(l.GetString(commaOnTheLastX0OfTheMonth), []string{p.dayOfWeek}, func(s *descState, values []string) bool {
+		day, ok := p.parseDayOfWeek(values[0])
+		return ok && s.set(FieldDayOfWeek, day+"L")
+	})
+	for n := 1; n <= 5; n++ {
+		nth := strconv.Itoa(n)
+		tmpl := l.GetString(commaOnThe) + getNthDescription(nth, l) + l.GetString(spaceX0OfTheMonth)
+		p.add(tmpl, []string{p.dayOfWeek}, func(s *descState, values []string) bool {
+			day, ok := p.parseDayOfWeek(values[0])
+			return ok && s.set(FieldDayOfWeek, day+"#"+nth)
+		})
+	}
+	p.addIntervalClauses(FieldDayOfWeek, commaEveryX0DaysOfTheWeek)
+}
+
+func (p *descParser) addMonthClauses() {
+	p.addSegmentClauses(FieldMonth, p.month, p.parseMonth, segmentKeys{
+		interval: commaEveryX0Months,
+		between:  p.orDefault(commaMonthX0ThroughMonthX1, commaX0ThroughX1),
+		single:   []LocaleKey{p.orDefault(commaOnlyInMonthX0, commaOnlyInX0)},
+	})
+}
+
+func (p *descParser) addYearClauses() {
+	p.addSegmentClauses(FieldYear, p.year, p.parseNumber, segmentKeys{
+		interval: commaEveryX0Years,
+		between:  p.orDefault(commaYearX0ThroughYearX1, commaX0ThroughX1),
+		single:   []LocaleKey{p.orDefault(commaOnlyInYearX0, commaOnlyInX0)},
+	})
+}
+
+// segmentKeys are the strings which describe a field in getSegmentDescription.
+type segmentKeys struct {
+	all      []LocaleKey // Every value (*)
+	interval LocaleKey   // Every n values (*/n)
+	between  LocaleKey   // Range (a-b)
+	single   []LocaleKey // Value or list of values (a or a,b,c)
+}
+
+// addSegmentClauses adds the clauses of the field described by getSegmentDescription.
+func (p *descParser) addSegmentClauses(field FieldType, item string, parse func(string) (string, bool), keys segmentKeys) {
+	l := p.locale
+	for _, key := range keys.all {
+		p.addLiteral(l.GetString(key), field, "*")
+	}
+	p.addIntervalClauses(field, keys.interval)
+	p.addRangeClause(field, l.GetString(keys.between), item, parse)
+
+	for _, key := range keys.single {
+		for _, form := range pluralForms(l, key) {
+			p.add(form, []string{p.list(item)}, func(s *descState, values []string) bool {
+				value, ok := p.parseList(values[0], parse)
+				return ok && s.set(field, value)
+			})
+
+			// Start of an interval (i.e. "every 5 minutes, starting at 10 minutes past the hour")
+			starting := sprintf(l.GetString(commaStartingX0), strings.TrimPrefix(strings.TrimSpace(form), ","))
+			p.add(starting, []string{item}, func(s *descState, values []string) bool {
+				value, ok := parse(values[0])
+				return ok && s.refine(field, value)
+			})
+		}
+	}
+}
+
+// addIntervalClauses adds the clauses of every n values of the field (i.e. "every 5 minutes").
+func (p *descParser) addIntervalClauses(field FieldType, key LocaleKey) {
+	for _, form := range pluralForms(p.locale, key) {
+		p.add(form, []string{p.num}, func(s *descState, values []string) bool {
+			if values[0] == "" || values[0] == "1" {
+				return s.set(field, "*")
+			}
+			return s.set(field, "*/"+values[0])
+		})
+	}
+}
+
+// addRangeClause adds the clause of the range of the field (i.e. "Monday through Friday"),
+// which is also the range of an interval (i.e. "every 2 hours, between 09:00 AM and 05:59 PM").
+func (p *descParser) addRangeClause(field FieldType, tmpl, item string, parse func(string) (string, bool)) {
+	p.add(tmpl, []string{item, item}, func(s *descState, values []string) bool {
+		from, ok1 := parse(values[0])
+		to, ok2 := parse(values[1])
+		if from == to { // Hours range in a single hour (i.e. "between 09:00 AM and 09:59 AM")
+			return ok1 && s.refine(field, from)
+		}
+		return ok1 && ok2 && s.refine(field, from+"-"+to)
+	})
+}
+
+func (p *descParser) addLiteral(tmpl string, field FieldType, value string) {
+	p.add(tmpl, nil, func(s *descState, values []string) bool {
+		return s.set(field, value)
+	})
+}
+
+// add adds the clause of the locale string, with its placeholders matching the patterns.
+// The leading comma of the string is optional, so the clauses can start the description.
+func (p *descParser) add(tmpl string, patterns []string, apply func(s *descState, values []string) bool) {
+	if strings.TrimSpace(strings.Replace(tmpl, "%s", "", -1)) == "" {
+		return
+	}
+	regex, err := regexp.Compile(`(?i)^(?:, ?)?` + templatePattern(tmpl, true, patterns...))
+	if err != nil {
+		return
+	}
+	p.clauses = append(p.clauses, descClause{regex: regex, apply: func(s *descState, values []string) bool {
+		return apply(s, append(values, "")) // The value of the forms without placeholder (i.e. "every minute") is ""
+	}})
+}
+
+// list returns the pattern of a list of items and ranges of items, i.e. "Monday, Wednesday through Friday, and Sunday".
+func (p *descParser) list(item string) string {
+	itemOrRange := `(?:` + templatePattern(p.rangeFormat(), false, item, item) + `|` + item + `)`
+	and := regexp.QuoteMeta(strings.TrimSpace(p.locale.GetString(spaceAnd)))
+	return itemOrRange + `(?:(?:, ?` + and + ` |, | ` + and + ` )` + itemOrRange + `)*`
+}
+
+// split returns the items of the list.
+func (p *descParser) split(list string) []string {
+	var items []string
+	for start, i := 0, 0; i <= len(list); i++ {
+		if i == len(list) {
+			items = append(items, list[start:])
+			break
+		}
+		if sep := p.sep.FindString(list[i:]); sep != "" {
+			items = append(items, list[start:i])
+			i += len(sep) - 1
+			start = i + 1
+		}
+	}
+	return items
+}
+
+// parseList returns the CRON values of the list of items and ranges of items, i.e. "1,3-5,7".
+func (p *descParser) parseList(list string, parse func(string) (string, bool)) (string, bool) {
+	rangeRegex, err := regexp.Compile(`(?i)^` + templatePattern(p.rangeFormat(), true, `.+`, `.+`) + `$`)
+	if err != nil {
+		return "", false
+	}
+	var values []string
+	for _, item := range p.split(list) {
+		if matches := rangeRegex.FindStringSubmatch(item); matches != nil {
+			from, ok1 := parse(matches[1])
+			to, ok2 := parse(matches[2])
+			if !ok1 || !ok2 {
+				return "", false
+			}
+			values = append(values, from+"-"+to)
+			continue
+		}
+		value, ok := parse(item)
+		if !ok {
+			return "", false
+		}
+		values = append(values, value)
+	}
+	return strings.Join(values, ","), len(values) > 0
+}
+
+// rangeFormat returns the format of the ranges in the lists, i.e. "%s through %s".
+func (p *descParser) rangeFormat() string {
+	return strings.TrimPrefix(strings.TrimSpace(p.locale.GetString(commaX0ThroughX1)), ",")
+}
+
+func (p *descParser) orDefault(key, defaultKey LocaleKey) LocaleKey {
+	if p.locale.GetString(key) != "" {
+		return key
+	}
+	return defaultKey
+}
+
+// parseTime parses the time of day formatted by formatTime, in the 12-hour or 24-hour format.
+func (p *descParser) parseTime(s string) (hour, minute, second int, hasSecond, ok bool) {
+	s = strings.TrimSpace(s)
+	if word := p.locale.GetString(noon); word != "" && strings.EqualFold(s, word) {
+		return 12, 0, 0, false, true
+	}
+	if word := p.locale.GetString(midnight); word != "" && strings.EqualFold(s, word) {
+		return 0, 0, 0, false, true
+	}
+	am, pm := getPeriod(0, p.locale), getPeriod(12, p.locale)
+	period := ""
+	for _, pr := range []string{am, pm} {
+		if len(s) > len(pr) && strings.EqualFold(s[len(s)-len(pr):], pr) {
+			period, s = pr, strings.TrimSpace(s[:len(s)-len(pr)])
+		} else if len(s) > len(pr) && strings.EqualFold(s[:len(pr)], pr) {
+			period, s = pr, strings.TrimSpace(s[len(pr):])
+		}
+	}
+
+	parts := strings.Split(s, ":")
+	values := make([]int, len(parts))
+	for i, part := range parts {
+		v, err := strconv.Atoi(part)
+		if err != nil || len(parts) > 3 {
+			return 0, 0, 0, false, false
+		}
+		values[i] = v
+	}
+	hour = values[0]
+	if len(values) > 1 {
+		minute = values[1]
+	}
+	if len(values) > 2 {
+		second, hasSecond = values[2], true
+	}
+
+	switch {
+	case period != "" && (hour < 1 || hour > 12):
+		return 0, 0, 0, false, false
+	case period == pm && hour < 12 && !strings.EqualFold(am, pm):
+		hour += 12
+	case period == am && hour == 12:
+		hour = 0
+	}
+	return hour, minute, second, hasSecond, hour < 24 && minute < 60 && second < 60
+}
+
+func (p *descParser) parseHour(s string) (string, bool) {
+	hour, _, _, _, ok := p.parseTime(s)
+	return strconv.Itoa(hour), ok
+}
+
+func (p *descParser) parseNumber(s string) (string, bool) {
+	n, err := strconv.Atoi(strings.TrimSpace(s))
+	return strconv.Itoa(n), err == nil
+}
+
+func (p *descParser) parseDay(s string) (string, bool) {
+	if strings.EqualFold(strings.TrimSpace(s), p.locale.GetString(lastDay)) {
+		return "L", true
+	}
+	digits := strings.TrimFunc(s, func(r rune) bool { return r < '0' || r > '9' })
+	return p.parseNumber(digits)
+}
+
+func (p *descParser) parseOrdinal(s string) (string, bool) {
+	for n := 1; n <= 31; n++ {
+		if strings.EqualFold(strings.TrimSpace(s), p.locale.GetOrdinal(n)) {
+			return strconv.Itoa(n), true
+		}
+	}
+	return "", false
+}
+
+func (p *descParser) parseDayOfWeek(s string) (string, bool) {
+	for i, name := range p.locale.GetSlice(daysOfTheWeek) {
+		if strings.EqualFold(strings.TrimSpace(s), name) {
+			if p.isDOWStartsAtOne {
+				i++
+			}
+			return strconv.Itoa(i), true
+		}
+	}
+	return "", false
+}
+
+func (p *descParser) parseMonth(s string) (string, bool) {
+	for i, name := range p.locale.GetSlice(monthsOfTheYear) {
+		if strings.EqualFold(strings.TrimSpace(s), name) {
+			return strconv.Itoa(i + 1), true
+		}
+	}
+	return "", false
+}
+
+// set sets the value of the field, which must not be described twice.
+func (s *descState) set(field FieldType, value string) bool {
+	if s.fields[field] != "" {
+		return false
+	}
+	s.fields[field] = value
+	return true
+}
+
+// refine sets the value of the field, or the range or start of its interval if the field is an interval
+// (i.e. "*/5" and "10-20" is "10-20/5").
+func (s *descState) refine(field FieldType, value string) bool {
+	if strings.HasPrefix(s.fields[field], "*/") {
+		s.fields[field] = value + s.fields[field][1:]
+		return true
+	}
+	return s.set(field, value)
+}
+
+// format returns the CRON expression of the fields in the layout. The time of day defaults to 12:00 AM
+// if it's not described, the seconds to 0 and the other fields to *.
+// If isQuestionMark, the day of month or day of week which is not described is ? (see DialectQuartz).
+func (s descState) format(layout FieldLayout, isQuestionMark bool) string {
+	fields := s.fields
+	isTimeDescribed := fields[FieldSecond] != "" || fields[FieldMinute] != "" || fields[FieldHour] != ""
+	switch {
+	case !isTimeDescribed:
+		fields[FieldMinute], fields[FieldHour] = "0", "0"
+	case fields[FieldMinute] == "" && fields[FieldSecond] != "":
+		fields[FieldMinute] = "*"
+	case fields[FieldMinute] == "":
+		fields[FieldMinute] = "0"
+	}
+	if fields[FieldSecond] == "" {
+		fields[FieldSecond] = "0"
+	}
+	for _, field := range []FieldType{FieldHour, FieldDayOfMonth, FieldMonth, FieldDayOfWeek, FieldYear} {
+		if fields[field] == "" {
+			fields[field] = "*"
+		}
+	}
+	if isQuestionMark {
+		setQuestionMark(&fields)
+	}
+
+	parts := make([]string, 0, len(fields))
+	for _, field := range layout.fields() {
+		parts = append(parts, fields[field])
+	}
+	return strings.Join(parts, " ")
+}
+
+// descLayout returns the layout of the fields of the CRON expression of the description: the layout of the options,
+// or the first layout of the dialect which has the described second and year.
+func (e *ExpressionDescriptor) descLayout(s descState) FieldLayout {
+	if e.fieldLayout != LayoutAuto {
+		return e.fieldLayout
+	}
+	layouts := e.dialect.layouts()
+	if len(layouts) == 0 {
+		// 6 part expressions only have a year if it's a 4-digit year, so the years are described in 7 parts
+		layouts = []FieldLayout{LayoutFiveFields, LayoutSecondsFirst, LayoutSevenFields}
+	}
+	return pickLayout(layouts, s.fields[FieldSecond] != "", s.fields[FieldYear] != "")
+}
+
+// templatePattern returns the regular expression of the locale string, with the %s placeholders replaced
+// by the patterns (captured if capture), i.e. "at (\d+) minutes past the hour".
+// The leading comma of the string is left out, and the spaces are normalized.
+func templatePattern(tmpl string, capture bool, patterns ...string) string {
+	tmpl = whitespaceRegex.ReplaceAllString(strings.TrimSpace(tmpl), " ")
+	tmpl = strings.TrimSpace(strings.TrimPrefix(tmpl, ","))
+	parts := strings.Split(tmpl, "%s")
+
+	var b strings.Builder
+	for i, part := range parts {
+		b.WriteString(regexp.QuoteMeta(part))
+		if i == len(parts)-1 {
+			break
+		}
+		pattern := `.+?`
+		if len(patterns) > 0 {
+			pattern = patterns[len(patterns)-1]
+			if i < len(patterns) {
+				pattern = patterns[i]
+			}
+		}
+		if capture {
+			b.WriteString("(" + pattern + ")")
+		} else {
+			b.WriteString("(?:" + pattern + ")")
+		}
+	}
+	return b.String()
+}
+
+// alternation returns the pattern which matches any of the values, the longest first.
+func alternation(values []string) string {
+	quoted := make([]string, 0, len(values))
+	for _, v := range values {
+		if v != "" {
+			quoted = append(quoted, regexp.QuoteMeta(v))
+		}
+	}
+	if len(quoted) == 0 {
+		return `(?:$^)`
+	}
+	sort.SliceStable(quoted, func(i, j int) bool {
+		return len(quoted[i]) > len(quoted[j])
+	})
+	return `(?:` + strings.Join(quoted, "|") + `)`
+}
+
+// pluralForms returns the distinct plural forms of the locale string.
+func pluralForms(locale Locale, key LocaleKey) []string {
+	var forms []string
+	seen := make(map[string]bool)
+	for _, count := range []int{0, 1, 2, 3, 5, 11, 21, 22, 101, 111} {
+		if form := locale.GetPluralString(key, count); form != "" && !seen[form] {
+			seen[form] = true
+			forms = append(forms, form)
+		}
+	}
+	return forms
+}
+
+func atoi(s string) int {
+	n, _ := strconv.Atoi(s)
+	return n
+}
@@ -0,0 +1,127 @@
+package cron
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestExpressionDescriptor_FromDescription(t *testing.T) {
+	type testCase struct {
+		inOptions []Option
+		inDesc    string
+		out       string
+		outErr    error
+	}
+
+	tcs := []testCase{
+		{inDesc: "Every 5 minutes", out: "*/5 * * * *"},
+		{inDesc: "every 5 minutes", out: "*/5 * * * *"},
+		{inDesc: "At 09:00 AM, Monday through Friday", out: "0 9 * * 1-5"},
+		{inDesc: "at 9 am, monday through friday.", out: "0 9 * * 1-5"},
+		{inDesc: "on the last weekday of the month", out: "0 0 LW * *"},
+		{inDesc: "At 09:30 PM, on the last weekday of the month", out: "30 21 LW * *"},
+		{inDesc: "Every minute", out: "* * * * *"},
+		{inDesc: "Every hour", out: "0 * * * *"},
+		{inDesc: "Every 10 seconds", out: "*/10 * * * * *"},
+		{inDesc: "At 06:30 AM, 02:30 PM and 04:30 PM", out: "30 6,14,16 * * *"},
+		{inDesc: "Every minute between 11:00 AM and 11:10 AM", out: "0-10 11 * * *"},
+		{inDesc: "Every hour, between 09:00 AM and 05:59 PM", out: "0 9-17 * * *"},
+		{inDesc: "At 0 minutes past the hour, every 2 hours, between 09:00 AM and 05:59 PM", out: "0 9-17/2 * * *"},
+		{inDesc: "At 12:00 PM, only on Monday, Wednesday, and Friday", out: "0 12 * * 1,3,5"},
+		{inDesc: "At 02:23 PM, on the second Sunday of the month", out: "23 14 * * 0#2"},
+		{inDesc: "At 10:15 AM, on the last Saturday of the month", out: "15 10 * * 6L"},
+		{inDesc: "At 12:00 AM, on day 1, 15, and the last day of the month", out: "0 0 1,15,L * *"},
+		{inDesc: "At 12:00 AM, 3 days before the last day of the month", out: "0 0 L-3 * *"},
+		{inDesc: "At 12:00 AM, on the weekday nearest day 15 of the month", out: "0 0 15W * *"},
+		{inDesc: "At 12:00 AM, every 5 days, starting on day 2 of the month", out: "0 0 2/5 * *"},
+		{inDesc: "At 12:00 AM, only in January, March, and May", out: "0 0 * 1,3,5 *"},
+		{inDesc: "At 12:00 AM, on day 1 of the month, only in January, 2020 through 2025", out: "0 0 0 1 1 * 2020-2025"},
+		{inDesc: "At system startup", out: "@reboot"},
+		{inDesc: "Every 90 minutes", out: "@every 90m"},
+		{inDesc: "Every 30 hours", out: "@every 30h"},
+		{inDesc: "Every day", out: "0 0 * * *"},
+		{inDesc: "Every 2 days", out: "0 0 */2 * *"},
+		{inDesc: "Every 2 hours", out: "0 */2 * * *"},
+		{inDesc: "Every 5 hours", out: "0 */5 * * *"},
+		{inDesc: "Every 120 minutes", out: "0 */2 * * *"},
+		{inDesc: "Every 2 hours", inOptions: []Option{SetDialect(DialectPOSIX)}, out: "0 */2 * * *"},
+		{inDesc: "Every day", inOptions: []Option{SetDialect(DialectQuartz)}, out: "0 0 0 * * ?"},
+		{inDesc: "At 12:00 PM, Monday through Friday", inOptions: []Option{DayOfWeekStartsAtOne(true)}, out: "0 12 * * 2-6"},
+		{inDesc: "At 12:00 PM, Monday through Friday", inOptions: []Option{SetDialect(DialectQuartz)}, out: "0 0 12 ? * 2-6"},
+		{inDesc: "At 12:00 PM, on day 15 of the month", inOptions: []Option{SetDialect(DialectEventBridge)}, out: "0 12 15 * ? *"},
+		{inDesc: "At 21:00", inOptions: []Option{Use24HourTimeFormat(true)}, out: "0 21 * * *"},
+		{inDesc: "At noon, on the 1st and 15th of the month", inOptions: []Option{UseOrdinals(true), TimeFormat("N|h[:mm] a")}, out: "0 12 1,15 * *"},
+		{inDesc: "", outErr: InvalidDescriptionError},
+		{inDesc: "Every blue moon", outErr: InvalidDescriptionError},
+		{inDesc: "At 09:00 AM, Monday through Someday", outErr: InvalidDescriptionError},
+		{inDesc: "At 09:00 AM, at 10:00 AM", outErr: InvalidDescriptionError},
+		{inDesc: "Every 90 minutes", inOptions: []Option{SetDialect(DialectPOSIX)}, outErr: InvalidExprError},
+	}
+
+	for i, tc := range tcs {
+		exprDesc, err := NewDescriptor(tc.inOptions...)
+		if err != nil {
+			t.Fatalf("failed to create expression descriptor: %s", err)
+		}
+		expr, err := exprDesc.FromDescription(tc.inDesc, Locale_en)
+		if tc.outErr != nil {
+			if !errors.Is(err, tc.outErr) {
+				t.Errorf("%d. %s: expected '%v' error, got '%v'", i, tc.inDesc, tc.outErr, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%d. %s: expected nil, got error '%v'", i, tc.inDesc, err)
+			continue
+		}
+		if expr != tc.out {
+			t.Errorf("%d. %s: expected '%s', got '%s'", i, tc.inDesc, tc.out, expr)
+		}
+	}
+}
+
+func TestExpressionDescriptor_FromDescription_RoundTrip(t *testing.T) {
+	type testCase struct {
+		inLocale  LocaleType
+		inVerbose bool
+		inExprs   []string
+	}
+
+	tcs := []testCase{
+		{
+			inLocale: Locale_en,
+			inExprs: []string{
+				"* * * * * *", "* * * * *", "*/5 * * * *", "0 * * * *", "0 9-17 * * *", "0 52 13 ? * 3/1",
+				"30 11 * * 1-5", "23 12 * Jan-Mar *", "1 1,3-4 * * *", "* 0 */4 * * *", "*/10 0 * * * *",
+				"5-10/2 * * * * *", "0 5/15 * * *", "30 2 */2 * * *", "0 0 1-5,10,20-25 * *", "* * * * 0-6/2",
+				"0 */10 9 * * 1-5 2020", "0 0 0 * * * */5", "0 0 12 1 * 1", "0 0 * */2 *", "0 0 1W * *",
+				"@reboot", "@every 25h", "@every 1h30m",
+			},
+		},
+		{inLocale: Locale_en, inVerbose: true, inExprs: []string{"* * * * *", "0 * * * *", "0 0 * * *", "* * 5 * * * *"}},
+		{inLocale: Locale_fr, inExprs: []string{"*/5 * * * *", "0 9 * * 1-5", "0 0 LW * *", "23 12 * Jan-Mar *"}},
+		{inLocale: Locale_de, inExprs: []string{"*/5 * * * *", "0 9 * * 1-5", "0 0 LW * *", "0 9-17 * * *"}},
+	}
+
+	for i, tc := range tcs {
+		exprDesc, err := NewDescriptor(SetLocales(tc.inLocale), Verbose(tc.inVerbose))
+		if err != nil {
+			t.Fatalf("failed to create expression descriptor: %s", err)
+		}
+		for _, inExpr := range tc.inExprs {
+			desc, err := exprDesc.ToDescription(inExpr, tc.inLocale)
+			if err != nil {
+				t.Errorf("%d. %s: expected nil, got error '%v'", i, inExpr, err)
+				continue
+			}
+			expr, err := exprDesc.FromDescription(desc, tc.inLocale)
+			if err != nil {
+				t.Errorf("%d. %s: expected nil, got error '%v' for '%s'", i, inExpr, err, desc)
+				continue
+			}
+			if got, _ := exprDesc.ToDescription(expr, tc.inLocale); got != desc {
+				t.Errorf("%d. %s: expected '%s', got '%s' for '%s'", i, inExpr, desc, got, expr)
+			}
+		}
+	}
+}
@@ -0,0 +1,126 @@
+//go:build go1.18
+// +build go1.18
+
+package cron
+
+import (
+	"reflect"
+	"testing"
+)
+
+var (
+	// fuzzSeeds are the inputs which panicked the parser or the descriptor.
+	fuzzSeeds = []string{
+		"", " ", "L-", "#", "W", "?", "cron()", "cron( )", "rate()", "@", "@every", "CRON_TZ=",
+		"0 0 * 0 *", "0 0 * 13 *", "0 0 * 1,jan *", "0 0 * jan,0 *", "0 0 * 0-2 *", "0 0 * */0 *",
+		"0 0 L- * *", "0 0 L-- * *", "0 0 LW-1 * *", "0 0 W * *", "0 0 * * #", "0 0 * * 1#", "0 0 * * #1",
+		"0 0 * * L", "0 0 * * 8", "0 0 * * sun#0", "0 0 * * 1-", "0 0 * * -1", "0 0 * * ,", "0 0 ,, * *",
+		"0 0 1/ * *", "0 0 /1 * *", "* * * * * * 0", "* * * * * 2013,", "0 0 * * * 99999",
+		"0 0 * 01--1 *", "0 0 * * 1--1", "0 0 +1 * *", "00 0 0 1 1 0", "0 0 * jan,jan *",
+		"00000 0 1 1,* 0", "0/01 0 1 1 0", "00/1 0 1 1 0", "0 0 1-1 1 0", "0-0 0 0 1 1 0",
+	}
+)
+
+// addFuzzSeeds adds the expressions of the locale test cases and the inputs which used to panic to the seed corpus.
+func addFuzzSeeds(f *testing.F) {
+	seen := make(map[string]bool)
+	for _, localeTestCases := range testLocales {
+		for _, tc := range localeTestCases {
+			if !seen[tc.inExpr] {
+				seen[tc.inExpr] = true
+				f.Add(tc.inExpr)
+			}
+		}
+	}
+	for _, seed := range fuzzSeeds {
+		f.Add(seed)
+	}
+}
+
+func FuzzParser_Parse(f *testing.F) {
+	addFuzzSeeds(f)
+
+	dialects := []Dialect{DialectDefault, DialectPOSIX, DialectVixie, DialectQuartz, DialectSpring, DialectEventBridge}
+	f.Fuzz(func(t *testing.T, expr string) {
+		for _, dialect := range dialects {
+			_, _ = NewParser(SetDialect(dialect), HashSeed("fuzz")).Parse(expr)
+
+			// The expressions which pass the strict validation are valid schedules
+			exprParts, err := NewParser(SetDialect(dialect), HashSeed("fuzz"), StrictValidation(true)).Parse(expr)
+			if err != nil {
+				continue
+			}
+			if _, err = NewSchedule(exprParts); err != nil {
+				t.Errorf("%s: %s: expected parsed expression %q to build a schedule, got error '%v'", dialect, expr, exprParts, err)
+			}
+		}
+	})
+}
+
+func FuzzExpressionDescriptor_ToDescription(f *testing.F) {
+	addFuzzSeeds(f)
+
+	var exprDescs []*ExpressionDescriptor
+	for _, options := range [][]Option{
+		{SetLocales(LocaleAll)},
+		{SetLocales(LocaleAll), Verbose(true), DayOfWeekStartsAtOne(true), UseOrdinals(true), UseBidiIsolates(true),
+			TimeFormat("N|h[:mm] a"), HashSeed("fuzz"), SymbolicHash(true)},
+	} {
+		exprDesc, err := NewDescriptor(options...)
+		if err != nil {
+			f.Fatalf("failed to create expression descriptor: %s", err)
+		}
+		exprDescs = append(exprDescs, exprDesc)
+	}
+
+	f.Fuzz(func(t *testing.T, expr string) {
+		for _, exprDesc := range exprDescs {
+			for _, loc := range RegisteredLocales() {
+				if desc, err := exprDesc.ToDescription(expr, loc); err == nil && desc == "" {
+					t.Errorf("%s: %s: expected description, got ''", loc, expr)
+				}
+			}
+		}
+	})
+}
+
+// FuzzSchedule_String checks that the canonical expression of a parsed expression parses back to the same fields,
+// with the descriptor it was parsed with.
+func FuzzSchedule_String(f *testing.F) {
+	addFuzzSeeds(f)
+
+	var exprDescs []*ExpressionDescriptor
+	for _, options := range [][]Option{
+		{},
+		{DayOfWeekStartsAtOne(true)},
+		{SetDialect(DialectQuartz)},
+		{SetDialect(DialectEventBridge)},
+		{SetFieldLayout(LayoutSecondsFirst)},
+	} {
+		exprDesc, err := NewDescriptor(options...)
+		if err != nil {
+			f.Fatalf("failed to create expression descriptor: %s", err)
+		}
+		exprDescs = append(exprDescs, exprDesc)
+	}
+
+	f.Fuzz(func(t *testing.T, expr string) {
+		for _, exprDesc := range exprDescs {
+			schedule, err := exprDesc.ToSchedule(expr)
+			if err != nil {
+				continue
+			}
+			canonical := schedule.String()
+			got, err := exprDesc.ToSchedule(canonical)
+			if err != nil {
+				t.Fatalf("%s: expected canonical expression '%s' to parse, got error '%v'", expr, canonical, err)
+			}
+			if !reflect.DeepEqual(got.Fields(), schedule.Fields()) || got.Location.String() != schedule.Location.String() {
+				t.Errorf("%s: expected canonical expression '%s' to parse to '%+v', got '%+v'", expr, canonical, schedule.Fields(), got.Fields())
+			}
+			if got.String() != canonical {
+				t.Errorf("%s: expected canonical expression '%s', got '%s'", expr, canonical, got.String())
+			}
+		}
+	})
+}
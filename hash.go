@@ -0,0 +1,261 @@
+package cron
+
+import (
+	"hash/fnv"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	hashRegex = regexp.MustCompile(`^h(?:\((\d+)-(\d+)\))?(?:/(\d+))?$`)
+)
+
+// hashItem is a Jenkins hashed item of a CRON expression part: H, H(from-to), H/interval or H(from-to)/interval.
+// Its value is derived from the hash seed, so the load of the jobs sharing the same expression is spread out.
+type hashItem struct {
+	from, to int
+	hasRange bool
+	interval int // 0 if the item has no step
+}
+
+// parseHashItem parses the hashed item, where hashRange is the default range of H and bounds is the range
+// of values allowed in the field. If the item is not a valid hashed item, ok is false.
+func parseHashItem(item string, hashRange, bounds [2]int) (h hashItem, ok bool) {
+	matches := hashRegex.FindStringSubmatch(item)
+	if matches == nil {
+		return hashItem{}, false
+	}
+
+	h.from, h.to = hashRange[0], hashRange[1]
+	if matches[1] != "" {
+		h.from, _ = strconv.Atoi(matches[1])
+		h.to, _ = strconv.Atoi(matches[2])
+		h.hasRange = true
+		if h.from > h.to || h.from < bounds[0] || h.to > bounds[1] {
+			return hashItem{}, false
+		}
+	}
+	if matches[3] != "" {
+		h.interval, _ = strconv.Atoi(matches[3])
+		if h.interval <= 0 {
+			return hashItem{}, false
+		}
+	}
+	return h, true
+}
+
+// resolve returns the concrete CRON item of the hashed item, i.e. H(0-7) => 5 and H/15 => 7/15.
+func (h hashItem) resolve(hash uint64) string {
+	span := h.to - h.from + 1
+	if h.interval == 0 {
+		return strconv.Itoa(h.from + int(hash%uint64(span)))
+	}
+
+	// The first value is picked within the first interval, so the step still fires in every interval
+	offsets := h.interval
+	if offsets > span {
+		offsets = span
+	}
+	start := strconv.Itoa(h.from + int(hash%uint64(offsets)))
+	if !h.hasRange {
+		return start + "/" + strconv.Itoa(h.interval)
+	}
+	return start + "-" + strconv.Itoa(h.to) + "/" + strconv.Itoa(h.interval)
+}
+
+// pattern returns the CRON item of the values the hashed item can be resolved to, i.e. H(0-7) => 0-7
+// and H/15 => */15.
+func (h hashItem) pattern() string {
+	pattern := "*"
+	if h.hasRange {
+		pattern = strconv.Itoa(h.from) + "-" + strconv.Itoa(h.to)
+	}
+	if h.interval > 0 {
+		pattern += "/" + strconv.Itoa(h.interval)
+	}
+	return pattern
+}
+
+// resolveHashes replaces the hashed items (H, H(a-b), H/n and H(a-b)/n) of the expression parts with the
+// concrete values derived from the hash seed.
+// The values are resolved in the numbering of the original expression, so they are normalized like the others.
+func (p *cronParser) resolveHashes(exprParts []string, tokens *exprTokens) error {
+	for i, part := range exprParts {
+		if !strings.Contains(part, "h") {
+			continue
+		}
+
+		typ := FieldType(i)
+		items := strings.Split(part, ",")
+		for j, item := range items {
+			if !isHashItem(item) {
+				continue // i.e. a misspelled name, reported as an invalid value
+			}
+			if !p.isHashEnabled {
+				return tokens.errorAt(typ, j, p.boundsOf(typ), "H requires a hash seed, see the HashSeed option", fieldErrors[typ])
+			}
+			if typ == FieldYear {
+				return tokens.errorAt(typ, j, p.boundsOf(typ), "year can't be hashed", fieldErrors[typ])
+			}
+
+			h, ok := parseHashItem(item, p.hashRange(typ), p.boundsOf(typ))
+			if !ok {
+				return tokens.errorAt(typ, j, p.boundsOf(typ), "hashed value must be H, H(a-b), H/n or H(a-b)/n", fieldErrors[typ])
+			}
+			items[j] = h.resolve(p.hash(typ, j))
+		}
+		exprParts[i] = strings.Join(items, ",")
+	}
+	return nil
+}
+
+// isHashItem reports whether the item is meant to be hashed: H, or H followed by a range or a step.
+func isHashItem(item string) bool {
+	return item == "h" || strings.HasPrefix(item, "h(") || strings.HasPrefix(item, "h/")
+}
+
+// hash returns the stable hash of the item at itemIdx (comma separated) of a field.
+func (p *cronParser) hash(typ FieldType, itemIdx int) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(p.hashSeed))
+	_, _ = h.Write([]byte{0, byte(typ), byte(itemIdx)})
+	return h.Sum64()
+}
+
+// hashRange returns the range of values H resolves to in the numbering of the original CRON expression.
+// As in Jenkins, H in day of month is limited to 1-28, so it fires in every month.
+func (p *cronParser) hashRange(typ FieldType) [2]int {
+	switch typ {
+	case FieldDayOfMonth:
+		return [2]int{1, 28}
+	case FieldDayOfWeek:
+		if p.isDOWStartsAtOne {
+			return [2]int{1, 7}
+		}
+		return [2]int{0, 6}
+	}
+	return fieldBounds[typ]
+}
+
+// boundsOf returns the range of values allowed in a field of the original CRON expression.
+func (p *cronParser) boundsOf(typ FieldType) [2]int {
+	switch typ {
+	case FieldDayOfWeek:
+		return p.dayOfWeekBounds()
+	}
+	return fieldBounds[typ]
+}
+
+// getHashDescriptions returns the symbolic descriptions of the hashed fields, i.e. "at a job-specific minute",
+// and the normalized expression parts where the hashed fields are replaced by the values they can resolve to.
+// Only the fields which consist of a single hashed item are described symbolically, the description of
+// the other fields is empty.
+func (e *ExpressionDescriptor) getHashDescriptions(tokens *exprTokens, locale Locale) (descs [7]string, exprParts []string) {
+	var hashes [7]*hashItem
+	exprParts = make([]string, len(tokens.parts))
+	for i, part := range tokens.parts {
+		exprParts[i] = strings.ToLower(part)
+	}
+	parser := e.parser.dialectParser(tokens.dialect)
+	if err := parser.resolveHashes(exprParts, tokens); err != nil {
+		return descs, nil
+	}
+
+	// Describe the values the hashed items can be resolved to, as if they were normal CRON items
+	isHashed := false
+	for i := FieldSecond; i < FieldYear; i++ {
+		h, ok := parseHashItem(strings.ToLower(tokens.parts[i]), parser.hashRange(i), parser.boundsOf(i))
+		if !ok {
+			continue
+		}
+		hashes[i] = &h
+		exprParts[i] = h.pattern()
+		isHashed = true
+	}
+	if !isHashed {
+		return descs, nil
+	}
+	if err := parser.normalize(exprParts, tokens); err != nil {
+		return descs, nil
+	}
+
+	phrases := [6]LocaleKey{
+		atAJobSpecificSecond,
+		atAJobSpecificMinute,
+		atAJobSpecificHour,
+		commaOnAJobSpecificDayOfTheMonth,
+		commaInAJobSpecificMonth,
+		commaOnAJobSpecificDayOfTheWeek,
+	}
+	for i, h := range hashes {
+		if h == nil {
+			continue
+		}
+
+		typ := FieldType(i)
+		var patternDesc string
+		switch typ {
+		case FieldSecond:
+			patternDesc = e.getSecondsDescription(exprParts, locale)
+		case FieldMinute:
+			patternDesc = e.getMinutesDescription(exprParts, locale)
+		case FieldHour:
+			patternDesc = e.getHoursDescription(exprParts, locale)
+		case FieldDayOfMonth:
+			patternDesc = e.getDayOfMonthDescription(exprParts, locale)
+		case FieldMonth:
+			patternDesc = e.getMonthDescription(exprParts, locale)
+		case FieldDayOfWeek:
+			patternDesc = e.getDayOfWeekDescription(exprParts, locale)
+		}
+
+		switch {
+		case h.interval > 0:
+			// i.e. every 15 minutes, starting at a job-specific offset
+			descs[i] = patternDesc + locale.GetString(commaStartingAtAJobSpecificOffset)
+		case h.hasRange && typ <= FieldHour:
+			// i.e. at a job-specific hour, between 12:00 AM and 07:59 AM
+			descs[i] = locale.GetString(phrases[i]) + ", " + patternDesc
+		case h.hasRange:
+			// i.e. , on a job-specific day of the week, Monday through Friday
+			descs[i] = locale.GetString(phrases[i]) + patternDesc
+		default:
+			descs[i] = locale.GetString(phrases[i])
+		}
+	}
+	return descs, exprParts
+}
+
+// getHashedTimeOfDayDescription describes the time of day when at least one of the second, minute and hour
+// fields is described symbolically, exprParts are the expression parts returned by getHashDescriptions.
+func (e *ExpressionDescriptor) getHashedTimeOfDayDescription(exprParts []string, hashDescs [7]string, locale Locale) string {
+	descs := []string{hashDescs[FieldSecond], hashDescs[FieldMinute], hashDescs[FieldHour]}
+	if descs[0] == "" {
+		descs[0] = e.getSecondsDescription(exprParts, locale)
+	}
+	if descs[1] == "" {
+		descs[1] = e.getMinutesDescription(exprParts, locale)
+		if hashDescs[FieldHour] != "" && !containsAny(exprParts[1], specialChars) {
+			// The minute of a job-specific hour doesn't fire every hour, so describe it relative to the hour
+			minute, _ := strconv.Atoi(exprParts[1])
+			format := pluralFormat(locale, atX0MinutesPastTheHour, exprParts[1])
+			if msg := locale.GetString(atX0MinutesPastTheHourGt20); minute >= 20 && msg != "" {
+				format = msg
+			}
+			descs[1] = sprintf(format, exprParts[1])
+		}
+	}
+	if descs[2] == "" {
+		descs[2] = e.getHoursDescription(exprParts, locale)
+	}
+
+	desc := ""
+	for _, d := range descs {
+		if desc != "" && d != "" {
+			desc += ", "
+		}
+		desc += d
+	}
+	return desc
+}
@@ -0,0 +1,137 @@
+package cron
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCronParser_Hash(t *testing.T) {
+	type testCase struct {
+		name                 string
+		inSeed               string
+		inTestDOWStartsAtOne bool
+		inExpr               string
+		outExprs             []string
+		outErr               error
+		outErrMessage        string // Part of the error message, if any
+	}
+
+	tcs := []testCase{
+		{name: "should resolve H", inSeed: "my-job", inExpr: "H H * * *", outExprs: []string{"", "42", "21", "*", "*", "*", ""}},
+		{name: "should resolve lowercase h", inSeed: "my-job", inExpr: "h h * * *", outExprs: []string{"", "42", "21", "*", "*", "*", ""}},
+		{name: "should resolve H in range", inSeed: "my-job", inExpr: "H H(0-7) * * *", outExprs: []string{"", "42", "5", "*", "*", "*", ""}},
+		{name: "should resolve H step", inSeed: "my-job", inExpr: "H/15 * * * *", outExprs: []string{"", "12/15", "*", "*", "*", "*", ""}},
+		{name: "should resolve H step in range", inSeed: "my-job", inExpr: "H(0-29)/10 * * * *", outExprs: []string{"", "2-29/10", "*", "*", "*", "*", ""}},
+		{name: "should resolve H in list", inSeed: "my-job", inExpr: "H,30 * * * *", outExprs: []string{"", "42,30", "*", "*", "*", "*", ""}},
+		{name: "should resolve H by seed", inSeed: "another-job", inExpr: "H H * * *", outExprs: []string{"", "51", "12", "*", "*", "*", ""}},
+		{name: "should resolve H day of week", inSeed: "my-job", inExpr: "0 0 * * H(1-5)", outExprs: []string{"", "0", "0", "*", "*", "3", ""}},
+		{name: "should resolve H day of week starts at one", inSeed: "my-job", inTestDOWStartsAtOne: true, inExpr: "0 0 * * H(1-5)", outExprs: []string{"", "0", "0", "*", "*", "2", ""}},
+		{name: "should fail on H without seed", inExpr: "H * * * *", outErr: InvalidExprMinuteError},
+		{name: "should fail on H range out of bounds", inSeed: "my-job", inExpr: "* H(0-24) * * *", outErr: InvalidExprHourError},
+		{name: "should fail on backward H range", inSeed: "my-job", inExpr: "* * H(10-5) * *", outErr: InvalidExprDayOfMonthError},
+		{name: "should fail on zero H step", inSeed: "my-job", inExpr: "H/0 * * * *", outErr: InvalidExprMinuteError},
+		{name: "should fail on malformed H", inSeed: "my-job", inExpr: "* * * H(1-5 *", outErr: InvalidExprMonthError},
+		{name: "should fail on H year", inSeed: "my-job", inExpr: "0 0 0 * * * H", outErr: InvalidExprYearError},
+		{name: "should fail on word starting with h without seed", inExpr: "hello * * * *", outErr: InvalidExprMinuteError, outErrMessage: "minute contains invalid values"},
+		{name: "should fail on word starting with h", inSeed: "my-job", inExpr: "0 9 * * hello", outErr: InvalidExprDayOfWeekError, outErrMessage: "DOW contains invalid values"},
+	}
+
+	for i, tc := range tcs {
+		options := []Option{DayOfWeekStartsAtOne(tc.inTestDOWStartsAtOne)}
+		if tc.inSeed != "" {
+			options = append(options, HashSeed(tc.inSeed))
+		}
+		parser := NewParser(options...)
+
+		parsed, err := parser.Parse(tc.inExpr)
+		if tc.outErr != nil {
+			if !errors.Is(err, tc.outErr) {
+				t.Errorf("%d. %s: expected '%v' error, got '%v'", i, tc.name, tc.outErr, err)
+			}
+			if !errors.As(err, new(*ParseError)) {
+				t.Errorf("%d. %s: expected *ParseError, got '%v'", i, tc.name, err)
+			}
+			if err != nil && !strings.Contains(err.Error(), tc.outErrMessage) {
+				t.Errorf("%d. %s: expected error containing '%s', got '%v'", i, tc.name, tc.outErrMessage, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%d. %s: expected nil, got error '%v'", i, tc.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(parsed, tc.outExprs) {
+			t.Errorf("%d. %s: expected '%v', got '%v'", i, tc.name, tc.outExprs, parsed)
+		}
+	}
+}
+
+func TestCronParser_HashRange(t *testing.T) {
+	for _, seed := range []string{"", "a", "b", "my-job", "another-job", "build/main"} {
+		parser := NewParser(HashSeed(seed))
+		parsed, err := parser.Parse("H H(0-7) H H(3-5) H")
+		if err != nil {
+			t.Errorf("%q: expected nil, got error '%v'", seed, err)
+			continue
+		}
+
+		schedule, err := NewSchedule(parsed)
+		if err != nil {
+			t.Errorf("%q: expected nil, got error '%v'", seed, err)
+			continue
+		}
+		if v := schedule.Hour.Items[0].(Value).Value; v > 7 {
+			t.Errorf("%q: expected hour in 0-7, got %d", seed, v)
+		}
+		if v := schedule.DayOfMonth.Items[0].(Value).Value; v < 1 || v > 28 {
+			t.Errorf("%q: expected day of month in 1-28, got %d", seed, v)
+		}
+		if v := schedule.Month.Items[0].(Value).Value; v < 3 || v > 5 {
+			t.Errorf("%q: expected month in 3-5, got %d", seed, v)
+		}
+	}
+}
+
+func TestExpressionDescriptor_Hash(t *testing.T) {
+	type testCase struct {
+		name       string
+		inSymbolic bool
+		inExpr     string
+		outDesc    string
+	}
+
+	tcs := []testCase{
+		{name: "resolved", inExpr: "H H(0-7) * * *", outDesc: "At 05:42 AM"},
+		{name: "resolved step", inExpr: "H/15 * * * *", outDesc: "Every 15 minutes, starting at 12 minutes past the hour"},
+		{name: "symbolic", inSymbolic: true, inExpr: "H * * * *", outDesc: "At a job-specific minute"},
+		{name: "symbolic range", inSymbolic: true, inExpr: "H H(0-7) * * *", outDesc: "At a job-specific minute, at a job-specific hour, between 12:00 AM and 07:59 AM"},
+		{name: "symbolic step", inSymbolic: true, inExpr: "H/15 * * * *", outDesc: "Every 15 minutes, starting at a job-specific offset"},
+		{name: "symbolic step in range", inSymbolic: true, inExpr: "H(0-29)/10 * * * *", outDesc: "Every 10 minutes, minutes 0 through 29 past the hour, starting at a job-specific offset"},
+		{name: "symbolic hour", inSymbolic: true, inExpr: "0 H * * *", outDesc: "At 0 minutes past the hour, at a job-specific hour"},
+		{name: "symbolic minute of fixed hour", inSymbolic: true, inExpr: "H 9 * * *", outDesc: "At a job-specific minute, between 09:00 AM and 09:59 AM"},
+		{name: "symbolic day of month", inSymbolic: true, inExpr: "H H H * *", outDesc: "At a job-specific minute, at a job-specific hour, on a job-specific day of the month"},
+		{name: "symbolic day of week", inSymbolic: true, inExpr: "0 0 * * H(1-5)", outDesc: "At 12:00 AM, on a job-specific day of the week, Monday through Friday"},
+		{name: "symbolic month", inSymbolic: true, inExpr: "0 0 1 H *", outDesc: "At 12:00 AM, on day 1 of the month, in a job-specific month"},
+		{name: "symbolic list falls back to resolved", inSymbolic: true, inExpr: "H,30 * * * *", outDesc: "At 42 and 30 minutes past the hour"},
+		{name: "symbolic without H", inSymbolic: true, inExpr: "*/5 * * * *", outDesc: "Every 5 minutes"},
+	}
+
+	for i, tc := range tcs {
+		exprDesc, err := NewDescriptor(HashSeed("my-job"), SymbolicHash(tc.inSymbolic))
+		if err != nil {
+			t.Errorf("failed to create expression descriptor: %s", err)
+			return
+		}
+
+		gotDesc, err := exprDesc.ToDescription(tc.inExpr, Locale_en)
+		if err != nil {
+			t.Errorf("%d. %s: expected nil, got error '%v'", i, tc.name, err)
+			continue
+		}
+		if gotDesc != tc.outDesc {
+			t.Errorf("%d. %s: expected '%v', got '%v'", i, tc.name, tc.outDesc, gotDesc)
+		}
+	}
+}
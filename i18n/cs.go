@@ -16,17 +16,41 @@ const Locale_cs = `
     "at": "V",
     "spaceAnd": " a",
     "everySecond": "každou sekundu",
-    "everyX0Seconds": "každých %s sekund",
+    "everyX0Seconds": {
+        "one": "každou %s sekundu",
+        "few": "každé %s sekundy",
+        "other": "každých %s sekund"
+    },
     "secondsX0ThroughX1PastTheMinute": "sekundy od %s do %s",
-    "atX0SecondsPastTheMinute": "v %s sekund",
-    "everyX0Minutes": "každých %s minut",
+    "atX0SecondsPastTheMinute": {
+        "one": "v %s sekundu",
+        "few": "v %s sekundy",
+        "other": "v %s sekund"
+    },
+    "everyX0Minutes": {
+        "one": "každou %s minutu",
+        "few": "každé %s minuty",
+        "other": "každých %s minut"
+    },
     "minutesX0ThroughX1PastTheHour": "minuty od %s do %s",
-    "atX0MinutesPastTheHour": "v %s minut",
-    "everyX0Hours": "každých %s hodin",
+    "atX0MinutesPastTheHour": {
+        "one": "v %s minutu",
+        "few": "v %s minuty",
+        "other": "v %s minut"
+    },
+    "everyX0Hours": {
+        "one": "každou %s hodinu",
+        "few": "každé %s hodiny",
+        "other": "každých %s hodin"
+    },
     "betweenX0AndX1": "mezi %s a %s",
     "atX0": "v %s",
     "commaEveryDay": ", každý den",
-    "commaEveryX0DaysOfTheWeek": ", každých %s dní v týdnu",
+    "commaEveryX0DaysOfTheWeek": {
+        "one": ", každý %s den v týdnu",
+        "few": ", každé %s dny v týdnu",
+        "other": ", každých %s dní v týdnu"
+    },
     "commaX0ThroughX1": ", od %s do %s",
     "first": "první",
     "second": "druhý",
@@ -39,19 +63,49 @@ const Locale_cs = `
     "commaOnTheLastX0OfTheMonth": ", poslední %s v měsíci",
     "commaOnlyOnX0": ", pouze v %s",
     "commaAndOnX0": ", a v %s",
-    "commaEveryX0Months": ", každých %s měsíců",
+    "commaEveryX0Months": {
+        "one": ", každý %s měsíc",
+        "few": ", každé %s měsíce",
+        "other": ", každých %s měsíců"
+    },
     "commaOnlyInX0": ", pouze v %s",
     "commaOnTheLastDayOfTheMonth": ", poslední den v měsíci",
     "commaOnTheLastWeekdayOfTheMonth": ", poslední pracovní den v měsíci",
-    "commaDaysBeforeTheLastDayOfTheMonth": ", %s dní před posledním dnem v měsíci",
+    "commaDaysBeforeTheLastDayOfTheMonth": {
+        "one": ", %s den před posledním dnem v měsíci",
+        "few": ", %s dny před posledním dnem v měsíci",
+        "other": ", %s dní před posledním dnem v měsíci"
+    },
     "firstWeekday": "první pracovní den",
     "weekdayNearestDayX0": "pracovní den nejblíže %s. dni",
     "commaOnTheX0OfTheMonth": ", v %s v měsíci",
-    "commaEveryX0Days": ", každých %s dnů",
+    "commaEveryX0Days": {
+        "one": ", každý %s den",
+        "few": ", každé %s dny",
+        "other": ", každých %s dnů"
+    },
     "commaBetweenDayX0AndX1OfTheMonth": ", mezi dny %s a %s v měsíci",
     "commaOnDayX0OfTheMonth": ", %s. den v měsíci",
-    "commaEveryX0Years": ", každých %s roků",
+    "commaEveryX0Years": {
+        "one": ", každý %s rok",
+        "few": ", každé %s roky",
+        "other": ", každých %s roků"
+    },
     "commaStartingX0": ", začínající %s",
+    "atSystemStartup": "při spuštění systému",
+    "atAJobSpecificSecond": "v sekundě specifické pro úlohu",
+    "atAJobSpecificMinute": "v minutě specifické pro úlohu",
+    "atAJobSpecificHour": "v hodině specifické pro úlohu",
+    "commaOnAJobSpecificDayOfTheMonth": ", v den měsíce specifický pro úlohu",
+    "commaInAJobSpecificMonth": ", v měsíci specifickém pro úlohu",
+    "commaOnAJobSpecificDayOfTheWeek": ", v den týdne specifický pro úlohu",
+    "commaStartingAtAJobSpecificOffset": ", počínaje posunem specifickým pro úlohu",
+    "everyDay": "každý den",
+    "everyX0Days": {
+        "one": "každý %s den",
+        "few": "každé %s dny",
+        "other": "každých %s dnů"
+    },
     "daysOfTheWeek": [
         "Neděle",
         "Pondělí",
@@ -77,3 +131,7 @@ const Locale_cs = `
     ]
 }
 `
+
+func init() {
+	register("cs", Locale_cs)
+}
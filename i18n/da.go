@@ -4,7 +4,6 @@ package i18n
 const Locale_da = `
 {
 	"confSetPeriodBeforeTime": false,
-	"confUse24HourTimeFormat": false,
 
     "at": "kl",
     "atSpace": "kl ",
@@ -53,6 +52,17 @@ const Locale_da = `
     "atX0MinutesPastTheHourGt20": "",
     "atX0SecondsPastTheMinuteGt20": "",
     "commaStartingX0": ", startende %s",
+    "atSystemStartup": "ved systemstart",
+    "atAJobSpecificSecond": "på et jobspecifikt sekund",
+    "atAJobSpecificMinute": "på et jobspecifikt minut",
+    "atAJobSpecificHour": "på en jobspecifik time",
+    "commaOnAJobSpecificDayOfTheMonth": ", på en jobspecifik dag i måneden",
+    "commaInAJobSpecificMonth": ", i en jobspecifik måned",
+    "commaOnAJobSpecificDayOfTheWeek": ", på en jobspecifik ugedag",
+    "commaStartingAtAJobSpecificOffset": ", startende ved en jobspecifik forskydning",
+    "everyDay": "hver dag",
+    "everyX0Days": "hver %s. dag",
+    "ordinalX0": "%s.",
     "daysOfTheWeek": [
         "søndag",
         "mandag",
@@ -78,3 +88,7 @@ const Locale_da = `
     ]
 }
 `
+
+func init() {
+	register("da", Locale_da)
+}
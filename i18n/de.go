@@ -52,6 +52,18 @@ const Locale_de = `
     "commaOnDayX0OfTheMonth": ", am %s Tag des Monats",
     "commaEveryX0Years": ", alle %s Jahre",
     "commaStartingX0": ", beginnend %s",
+    "atSystemStartup": "beim Systemstart",
+    "atAJobSpecificSecond": "zu einer jobspezifischen Sekunde",
+    "atAJobSpecificMinute": "zu einer jobspezifischen Minute",
+    "atAJobSpecificHour": "zu einer jobspezifischen Stunde",
+    "commaOnAJobSpecificDayOfTheMonth": ", an einem jobspezifischen Tag des Monats",
+    "commaInAJobSpecificMonth": ", in einem jobspezifischen Monat",
+    "commaOnAJobSpecificDayOfTheWeek": ", an einem jobspezifischen Wochentag",
+    "commaStartingAtAJobSpecificOffset": ", beginnend mit einem jobspezifischen Versatz",
+    "everyDay": "jeden Tag",
+    "everyX0Days": "alle %s Tage",
+    "ordinalX0": "%s.",
+    "commaOnTheX0ToLastDayOfTheMonth": ", am %s letzten Tag des Monats",
     "daysOfTheWeek": [
         "Sonntag",
         "Montag",
@@ -77,3 +89,7 @@ const Locale_de = `
     ]
 }
 `
+
+func init() {
+	register("de", Locale_de)
+}
@@ -12,17 +12,35 @@ const Locale_en = `
     "at": "At",
     "spaceAnd": " and",
     "everySecond": "every second",
-    "everyX0Seconds": "every %s seconds",
+    "everyX0Seconds": {
+        "one": "every second",
+        "other": "every %s seconds"
+    },
     "secondsX0ThroughX1PastTheMinute": "seconds %s through %s past the minute",
-    "atX0SecondsPastTheMinute": "at %s seconds past the minute",
-    "everyX0Minutes": "every %s minutes",
+    "atX0SecondsPastTheMinute": {
+        "one": "at %s second past the minute",
+        "other": "at %s seconds past the minute"
+    },
+    "everyX0Minutes": {
+        "one": "every minute",
+        "other": "every %s minutes"
+    },
     "minutesX0ThroughX1PastTheHour": "minutes %s through %s past the hour",
-    "atX0MinutesPastTheHour": "at %s minutes past the hour",
-    "everyX0Hours": "every %s hours",
+    "atX0MinutesPastTheHour": {
+        "one": "at %s minute past the hour",
+        "other": "at %s minutes past the hour"
+    },
+    "everyX0Hours": {
+        "one": "every hour",
+        "other": "every %s hours"
+    },
     "betweenX0AndX1": "between %s and %s",
     "atX0": "at %s",
     "commaEveryDay": ", every day",
-    "commaEveryX0DaysOfTheWeek": ", every %s days of the week",
+    "commaEveryX0DaysOfTheWeek": {
+        "one": ", every day of the week",
+        "other": ", every %s days of the week"
+    },
     "commaX0ThroughX1": ", %s through %s",
     "first": "first",
     "second": "second",
@@ -35,20 +53,55 @@ const Locale_en = `
     "commaOnTheLastX0OfTheMonth": ", on the last %s of the month",
     "commaOnlyOnX0": ", only on %s",
     "commaAndOnX0": ", and on %s",
-    "commaEveryX0Months": ", every %s months",
+    "commaEveryX0Months": {
+        "one": ", every month",
+        "other": ", every %s months"
+    },
     "commaOnlyInX0": ", only in %s",
     "commaOnTheLastDayOfTheMonth": ", on the last day of the month",
     "commaOnTheLastWeekdayOfTheMonth": ", on the last weekday of the month",
-    "commaDaysBeforeTheLastDayOfTheMonth": ", %s days before the last day of the month",
+    "commaDaysBeforeTheLastDayOfTheMonth": {
+        "one": ", %s day before the last day of the month",
+        "other": ", %s days before the last day of the month"
+    },
     "firstWeekday": "first weekday",
     "weekdayNearestDayX0": "weekday nearest day %s",
     "commaOnTheX0OfTheMonth": ", on the %s of the month",
-    "commaEveryX0Days": ", every %s days",
+    "commaEveryX0Days": {
+        "one": ", every day",
+        "other": ", every %s days"
+    },
     "commaBetweenDayX0AndX1OfTheMonth": ", between day %s and %s of the month",
     "commaOnDayX0OfTheMonth": ", on day %s of the month",
     "commaEveryHour": ", every hour",
-    "commaEveryX0Years": ", every %s years",
+    "commaEveryX0Years": {
+        "one": ", every year",
+        "other": ", every %s years"
+    },
     "commaStartingX0": ", starting %s",
+    "atSystemStartup": "at system startup",
+    "atAJobSpecificSecond": "at a job-specific second",
+    "atAJobSpecificMinute": "at a job-specific minute",
+    "atAJobSpecificHour": "at a job-specific hour",
+    "commaOnAJobSpecificDayOfTheMonth": ", on a job-specific day of the month",
+    "commaInAJobSpecificMonth": ", in a job-specific month",
+    "commaOnAJobSpecificDayOfTheWeek": ", on a job-specific day of the week",
+    "commaStartingAtAJobSpecificOffset": ", starting at a job-specific offset",
+    "everyDay": "every day",
+    "everyX0Days": {
+        "one": "every day",
+        "other": "every %s days"
+    },
+    "ordinalX0": {
+        "one": "%sst",
+        "two": "%snd",
+        "few": "%srd",
+        "other": "%sth"
+    },
+    "commaOnTheX0ToLastDayOfTheMonth": ", on the %s to last day of the month",
+    "noon": "noon",
+    "midnight": "midnight",
+    "spaceX0Day": " %s day",
     "daysOfTheWeek": [
         "Sunday",
         "Monday",
@@ -73,3 +126,7 @@ const Locale_en = `
         "December"
     ]
 }`
+
+func init() {
+	register("en", Locale_en)
+}
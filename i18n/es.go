@@ -52,6 +52,16 @@ const Locale_es = `
     "weekdayNearestDayX0": "día de la semana más próximo al %s",
     "commaEveryX0Years": ", cada %s años",
     "commaStartingX0": ", comenzando %s",
+    "atSystemStartup": "al iniciar el sistema",
+    "atAJobSpecificSecond": "en un segundo específico del trabajo",
+    "atAJobSpecificMinute": "en un minuto específico del trabajo",
+    "atAJobSpecificHour": "a una hora específica del trabajo",
+    "commaOnAJobSpecificDayOfTheMonth": ", en un día del mes específico del trabajo",
+    "commaInAJobSpecificMonth": ", en un mes específico del trabajo",
+    "commaOnAJobSpecificDayOfTheWeek": ", en un día de la semana específico del trabajo",
+    "commaStartingAtAJobSpecificOffset": ", comenzando en un desfase específico del trabajo",
+    "everyDay": "cada día",
+    "everyX0Days": "cada %s días",
     "daysOfTheWeek": [
         "domingo",
         "lunes",
@@ -77,3 +87,7 @@ const Locale_es = `
     ]
 }
 `
+
+func init() {
+	register("es", Locale_es)
+}
@@ -50,10 +50,20 @@ const Locale_fa = `
     "commaEveryX0Days": ", هر %s روز",
     "commaBetweenDayX0AndX1OfTheMonth": ", بین روز %s و %s ماه",
     "commaOnDayX0OfTheMonth": ", در %s ماه",
-    "commaEveryMinute": ", هر minute",
     "commaEveryHour": ", هر ساعت",
     "commaEveryX0Years": ", هر %s سال",
     "commaStartingX0": ", آغاز %s",
+    "atSystemStartup": "هنگام راه‌اندازی سیستم",
+    "atAJobSpecificSecond": "در ثانیه‌ای مختص کار",
+    "atAJobSpecificMinute": "در دقیقه‌ای مختص کار",
+    "atAJobSpecificHour": "در ساعتی مختص کار",
+    "commaOnAJobSpecificDayOfTheMonth": ", در روزی از ماه مختص کار",
+    "commaInAJobSpecificMonth": ", در ماهی مختص کار",
+    "commaOnAJobSpecificDayOfTheWeek": ", در روزی از هفته مختص کار",
+    "commaStartingAtAJobSpecificOffset": ", با شروع از جابه‌جایی مختص کار",
+    "everyDay": "هر روز",
+    "everyX0Days": "هر %s روز",
+    "digits": "۰۱۲۳۴۵۶۷۸۹",
     "daysOfTheWeek": [
         "یک‌شنبه",
         "دوشنبه",
@@ -79,3 +89,7 @@ const Locale_fa = `
     ]
 }
 `
+
+func init() {
+	register("fa", Locale_fa)
+}
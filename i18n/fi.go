@@ -15,7 +15,6 @@ const Locale_fi = `
     "commaBetweenDayX0AndX1OfTheMonth": ", kuukauden päivien %s ja %s välillä",
     "commaEveryDay": ", joka päivä",
     "commaEveryHour": ", joka tunti",
-    "commaEveryMinute": ", joka minuutti",
     "commaEveryX0Days": ", joka %s. päivä",
     "commaEveryX0DaysOfTheWeek": ", joka %s. viikonpäivä",
     "commaEveryX0Months": ", joka %s. kuukausi",
@@ -46,7 +45,6 @@ const Locale_fi = `
     "second": "toinen",
     "secondsX0ThroughX1PastTheMinute": "joka minuutti sekunttien %s - %s välillä",
     "spaceAnd": " ja",
-    "spaceAndSpace": " ja ",
     "spaceX0OfTheMonth": " %s kuukaudessa",
     "third": "kolmas",
     "weekdayNearestDayX0": "viikonpäivä lähintä %s päivää",
@@ -55,6 +53,17 @@ const Locale_fi = `
     "commaYearX0ThroughYearX1": "",
     "lastDay": "viimeinen päivä",
     "commaAndOnX0": ", ja edelleen %s",
+    "atSystemStartup": "järjestelmän käynnistyessä",
+    "atAJobSpecificSecond": "työkohtaisena sekuntina",
+    "atAJobSpecificMinute": "työkohtaisena minuuttina",
+    "atAJobSpecificHour": "työkohtaisena tuntina",
+    "commaOnAJobSpecificDayOfTheMonth": ", työkohtaisena kuukauden päivänä",
+    "commaInAJobSpecificMonth": ", työkohtaisena kuukautena",
+    "commaOnAJobSpecificDayOfTheWeek": ", työkohtaisena viikonpäivänä",
+    "commaStartingAtAJobSpecificOffset": ", alkaen työkohtaisesta siirtymästä",
+    "everyDay": "joka päivä",
+    "everyX0Days": "joka %s. päivä",
+    "ordinalX0": "%s.",
     "daysOfTheWeek": [
         "sunnuntai",
         "maanantai",
@@ -80,3 +89,7 @@ const Locale_fi = `
     ]
 }
 `
+
+func init() {
+	register("fi", Locale_fi)
+}
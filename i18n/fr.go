@@ -51,8 +51,23 @@ const Locale_fr = `
     "commaBetweenDayX0AndX1OfTheMonth": ", du %s au %s du mois",
     "commaOnDayX0OfTheMonth": ", le %s du mois",
     "commaEveryX0Years": ", tous les %s ans",
-    "commaDaysX0ThroughX1": ", du %s au %s",
     "commaStartingX0": ", départ %s",
+    "atSystemStartup": "au démarrage du système",
+    "atAJobSpecificSecond": "à une seconde propre à la tâche",
+    "atAJobSpecificMinute": "à une minute propre à la tâche",
+    "atAJobSpecificHour": "à une heure propre à la tâche",
+    "commaOnAJobSpecificDayOfTheMonth": ", un jour du mois propre à la tâche",
+    "commaInAJobSpecificMonth": ", en un mois propre à la tâche",
+    "commaOnAJobSpecificDayOfTheWeek": ", un jour de la semaine propre à la tâche",
+    "commaStartingAtAJobSpecificOffset": ", en commençant à un décalage propre à la tâche",
+    "everyDay": "tous les jours",
+    "everyX0Days": "tous les %s jours",
+    "ordinalX0": {
+        "one": "%ser",
+        "other": "%s"
+    },
+    "noon": "midi",
+    "midnight": "minuit",
     "daysOfTheWeek": [
         "dimanche",
         "lundi",
@@ -78,3 +93,7 @@ const Locale_fr = `
     ]
 }
 `
+
+func init() {
+	register("fr", Locale_fr)
+}
@@ -52,6 +52,16 @@ const Locale_he = `
     "commaOnDayX0OfTheMonth": ", ביום ה%s של החודש",
     "commaEveryX0Years": ", כל %s שנים",
     "commaStartingX0": ", החל מ %s",
+    "atSystemStartup": "בעת הפעלת המערכת",
+    "atAJobSpecificSecond": "בשנייה ייחודית למשימה",
+    "atAJobSpecificMinute": "בדקה ייחודית למשימה",
+    "atAJobSpecificHour": "בשעה ייחודית למשימה",
+    "commaOnAJobSpecificDayOfTheMonth": ", ביום בחודש ייחודי למשימה",
+    "commaInAJobSpecificMonth": ", בחודש ייחודי למשימה",
+    "commaOnAJobSpecificDayOfTheWeek": ", ביום בשבוע ייחודי למשימה",
+    "commaStartingAtAJobSpecificOffset": ", החל מהיסט ייחודי למשימה",
+    "everyDay": "כל יום",
+    "everyX0Days": "כל %s ימים",
     "daysOfTheWeek": [
         "יום ראשון",
         "יום שני",
@@ -76,3 +86,7 @@ const Locale_he = `
         "דצמבר"
     ]
 }`
+
+func init() {
+	register("he", Locale_he)
+}
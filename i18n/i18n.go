@@ -0,0 +1,21 @@
+// Package i18n holds the built-in locales of the CRON expression descriptor, as JSON documents.
+// Each locale registers itself, so the descriptor finds it by its locale type without a list to maintain.
+package i18n
+
+var (
+	locales = make(map[string]string)
+)
+
+// register registers the JSON document of the built-in locale typ (i.e. "pt_BR").
+func register(typ, data string) {
+	locales[typ] = data
+}
+
+// Locales returns the JSON documents of the built-in locales, by locale type (i.e. "pt_BR").
+func Locales() map[string]string {
+	copied := make(map[string]string, len(locales))
+	for typ, data := range locales {
+		copied[typ] = data
+	}
+	return copied
+}
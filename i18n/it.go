@@ -52,6 +52,16 @@ const Locale_it = `
     "third": "terzo",
     "weekdayNearestDayX0": "giorno della settimana più vicino al %s",
     "commaStartingX0": ", a partire %s",
+    "atSystemStartup": "all'avvio del sistema",
+    "atAJobSpecificSecond": "a un secondo specifico del job",
+    "atAJobSpecificMinute": "a un minuto specifico del job",
+    "atAJobSpecificHour": "a un'ora specifica del job",
+    "commaOnAJobSpecificDayOfTheMonth": ", in un giorno del mese specifico del job",
+    "commaInAJobSpecificMonth": ", in un mese specifico del job",
+    "commaOnAJobSpecificDayOfTheWeek": ", in un giorno della settimana specifico del job",
+    "commaStartingAtAJobSpecificOffset": ", a partire da uno scostamento specifico del job",
+    "everyDay": "ogni giorno",
+    "everyX0Days": "ogni %s giorni",
     "daysOfTheWeek": [
         "domenica",
         "lunedì",
@@ -77,3 +87,7 @@ const Locale_it = `
     ]
 }
 `
+
+func init() {
+	register("it", Locale_it)
+}
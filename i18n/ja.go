@@ -43,13 +43,9 @@ const Locale_ja = `
     "commaEveryX0Days": "、%s 日ごと",
     "commaBetweenDayX0AndX1OfTheMonth": "、月の %s 日から %s 日の間",
     "commaOnDayX0OfTheMonth": "、月の %s 日目",
-    "spaceAndSpace": "と",
-    "commaEveryMinute": "、毎分",
     "commaEveryHour": "、毎時",
     "commaEveryX0Years": "、%s 年ごと",
     "commaStartingX0": "、%s に開始",
-    "aMPeriod": "AM",
-    "pMPeriod": "PM",
     "commaDaysBeforeTheLastDayOfTheMonth": "月の最終日の %s 日前",
     "atX0SecondsPastTheMinuteGt20": "",
     "atX0MinutesPastTheHourGt20": "",
@@ -57,6 +53,16 @@ const Locale_ja = `
     "commaYearX0ThroughYearX1": "",
     "lastDay": "最終日",
     "commaAndOnX0": "、〜と %s",
+    "atSystemStartup": "システム起動時",
+    "atAJobSpecificSecond": "ジョブ固有の秒に",
+    "atAJobSpecificMinute": "ジョブ固有の分に",
+    "atAJobSpecificHour": "ジョブ固有の時に",
+    "commaOnAJobSpecificDayOfTheMonth": "、ジョブ固有の日に",
+    "commaInAJobSpecificMonth": "、ジョブ固有の月に",
+    "commaOnAJobSpecificDayOfTheWeek": "、ジョブ固有の曜日に",
+    "commaStartingAtAJobSpecificOffset": "、ジョブ固有のオフセットから開始",
+    "everyDay": "毎日",
+    "everyX0Days": "%s 日ごと",
     "daysOfTheWeek": [
         "日曜日",
         "月曜日",
@@ -81,3 +87,7 @@ const Locale_ja = `
         "12月"
     ]
 }`
+
+func init() {
+	register("ja", Locale_ja)
+}
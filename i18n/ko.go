@@ -52,10 +52,19 @@ const Locale_ko = `
     "commaEveryX0Days": ", %s일마다",
     "commaBetweenDayX0AndX1OfTheMonth": ", 해당 월의 %s일 및 %s일 사이",
     "commaOnDayX0OfTheMonth": ", 해당 월의 %s일에",
-    "commaEveryMinute": ", 1분마다",
     "commaEveryHour": ", 1시간마다",
     "commaEveryX0Years": ", %s년마다",
     "commaStartingX0": ", %s부터",
+    "atSystemStartup": "시스템 시작 시",
+    "atAJobSpecificSecond": "작업별 초에",
+    "atAJobSpecificMinute": "작업별 분에",
+    "atAJobSpecificHour": "작업별 시에",
+    "commaOnAJobSpecificDayOfTheMonth": ", 작업별 날짜에",
+    "commaInAJobSpecificMonth": ", 작업별 월에",
+    "commaOnAJobSpecificDayOfTheWeek": ", 작업별 요일에",
+    "commaStartingAtAJobSpecificOffset": ", 작업별 오프셋부터 시작",
+    "everyDay": "매일",
+    "everyX0Days": "%s일마다",
     "daysOfTheWeek": [
         "일요일",
         "월요일",
@@ -81,3 +90,7 @@ const Locale_ko = `
     ]
 }
 `
+
+func init() {
+	register("ko", Locale_ko)
+}
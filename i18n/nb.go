@@ -52,6 +52,17 @@ const Locale_nb = `
     "third": "tredje",
     "weekdayNearestDayX0": "ukedag nærmest dag %s",
     "commaStartingX0": ", starter %s",
+    "atSystemStartup": "ved systemoppstart",
+    "atAJobSpecificSecond": "på et jobbspesifikt sekund",
+    "atAJobSpecificMinute": "på et jobbspesifikt minutt",
+    "atAJobSpecificHour": "på en jobbspesifikk time",
+    "commaOnAJobSpecificDayOfTheMonth": ", på en jobbspesifikk dag i måneden",
+    "commaInAJobSpecificMonth": ", i en jobbspesifikk måned",
+    "commaOnAJobSpecificDayOfTheWeek": ", på en jobbspesifikk ukedag",
+    "commaStartingAtAJobSpecificOffset": ", med start ved en jobbspesifikk forskyvning",
+    "everyDay": "hver dag",
+    "everyX0Days": "hver %s dag",
+    "ordinalX0": "%s.",
     "daysOfTheWeek": [
         "søndag",
         "mandag",
@@ -77,3 +88,7 @@ const Locale_nb = `
     ]
 }
 `
+
+func init() {
+	register("nb", Locale_nb)
+}
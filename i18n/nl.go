@@ -52,6 +52,17 @@ const Locale_nl = `
     "commaOnDayX0OfTheMonth": ", op dag %s van de maand",
     "commaEveryX0Years": ", elke %s jaren",
     "commaStartingX0": ", beginnend %s",
+    "atSystemStartup": "bij het opstarten van het systeem",
+    "atAJobSpecificSecond": "op een taakspecifieke seconde",
+    "atAJobSpecificMinute": "op een taakspecifieke minuut",
+    "atAJobSpecificHour": "op een taakspecifiek uur",
+    "commaOnAJobSpecificDayOfTheMonth": ", op een taakspecifieke dag van de maand",
+    "commaInAJobSpecificMonth": ", in een taakspecifieke maand",
+    "commaOnAJobSpecificDayOfTheWeek": ", op een taakspecifieke dag van de week",
+    "commaStartingAtAJobSpecificOffset": ", beginnend bij een taakspecifieke verschuiving",
+    "everyDay": "elke dag",
+    "everyX0Days": "elke %s dagen",
+    "ordinalX0": "%se",
     "daysOfTheWeek": [
         "zondag",
         "maandag",
@@ -77,3 +88,7 @@ const Locale_nl = `
     ]
 }
 `
+
+func init() {
+	register("nl", Locale_nl)
+}
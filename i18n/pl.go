@@ -17,10 +17,30 @@ const Locale_pl = `
     "betweenX0AndX1": "od %s do %s",
     "commaBetweenDayX0AndX1OfTheMonth": ", od %s-ego do %s-ego dnia miesiąca",
     "commaEveryDay": ", co dzień",
-    "commaEveryX0Days": ", co %s dni",
-    "commaEveryX0DaysOfTheWeek": ", co %s dni tygodnia",
-    "commaEveryX0Months": ", co %s miesięcy",
-    "commaEveryX0Years": ", co %s lat",
+    "commaEveryX0Days": {
+        "one": ", co %s dzień",
+        "few": ", co %s dni",
+        "many": ", co %s dni",
+        "other": ", co %s dni"
+    },
+    "commaEveryX0DaysOfTheWeek": {
+        "one": ", co %s dzień tygodnia",
+        "few": ", co %s dni tygodnia",
+        "many": ", co %s dni tygodnia",
+        "other": ", co %s dni tygodnia"
+    },
+    "commaEveryX0Months": {
+        "one": ", co %s miesiąc",
+        "few": ", co %s miesiące",
+        "many": ", co %s miesięcy",
+        "other": ", co %s miesięcy"
+    },
+    "commaEveryX0Years": {
+        "one": ", co %s rok",
+        "few": ", co %s lata",
+        "many": ", co %s lat",
+        "other": ", co %s lat"
+    },
     "commaOnDayX0OfTheMonth": ", %s-ego dnia miesiąca",
     "commaOnlyInX0": ", tylko %s",
     "commaOnlyOnX0": ", tylko %s",
@@ -28,7 +48,12 @@ const Locale_pl = `
     "commaOnThe": ", ",
     "commaOnTheLastDayOfTheMonth": ", ostatni dzień miesiąca",
     "commaOnTheLastWeekdayOfTheMonth": ", ostatni dzień roboczy miesiąca",
-    "commaDaysBeforeTheLastDayOfTheMonth": ", %s dni przed ostatnim dniem miesiąca",
+    "commaDaysBeforeTheLastDayOfTheMonth": {
+        "one": ", %s dzień przed ostatnim dniem miesiąca",
+        "few": ", %s dni przed ostatnim dniem miesiąca",
+        "many": ", %s dni przed ostatnim dniem miesiąca",
+        "other": ", %s dni przed ostatnim dniem miesiąca"
+    },
     "commaOnTheLastX0OfTheMonth": ", ostatni %s miesiąca",
     "commaOnTheX0OfTheMonth": ", %s miesiąca",
     "commaX0ThroughX1": ", od %s do %s",
@@ -36,9 +61,24 @@ const Locale_pl = `
     "everyMinute": "co minutę",
     "everyMinuteBetweenX0AndX1": "Co minutę od %s do %s",
     "everySecond": "co sekundę",
-    "everyX0Hours": "co %s godzin",
-    "everyX0Minutes": "co %s minut",
-    "everyX0Seconds": "co %s sekund",
+    "everyX0Hours": {
+        "one": "co %s godzinę",
+        "few": "co %s godziny",
+        "many": "co %s godzin",
+        "other": "co %s godzin"
+    },
+    "everyX0Minutes": {
+        "one": "co %s minutę",
+        "few": "co %s minuty",
+        "many": "co %s minut",
+        "other": "co %s minut"
+    },
+    "everyX0Seconds": {
+        "one": "co %s sekundę",
+        "few": "co %s sekundy",
+        "many": "co %s sekund",
+        "other": "co %s sekund"
+    },
     "fifth": "piąty",
     "first": "pierwszy",
     "firstWeekday": "pierwszy dzień roboczy",
@@ -52,6 +92,21 @@ const Locale_pl = `
     "third": "trzeci",
     "weekdayNearestDayX0": "dzień roboczy najbliższy %s-ego dnia",
     "commaStartingX0": ", startowy %s",
+    "atSystemStartup": "przy uruchomieniu systemu",
+    "atAJobSpecificSecond": "w sekundzie właściwej dla zadania",
+    "atAJobSpecificMinute": "w minucie właściwej dla zadania",
+    "atAJobSpecificHour": "o godzinie właściwej dla zadania",
+    "commaOnAJobSpecificDayOfTheMonth": ", w dniu miesiąca właściwym dla zadania",
+    "commaInAJobSpecificMonth": ", w miesiącu właściwym dla zadania",
+    "commaOnAJobSpecificDayOfTheWeek": ", w dniu tygodnia właściwym dla zadania",
+    "commaStartingAtAJobSpecificOffset": ", zaczynając od przesunięcia właściwego dla zadania",
+    "everyDay": "co dzień",
+    "everyX0Days": {
+        "one": "co %s dzień",
+        "few": "co %s dni",
+        "many": "co %s dni",
+        "other": "co %s dni"
+    },
     "daysOfTheWeek": [
         "niedziela",
         "poniedziałek",
@@ -77,3 +132,7 @@ const Locale_pl = `
     ]
 }
 `
+
+func init() {
+	register("pl", Locale_pl)
+}
@@ -52,6 +52,16 @@ const Locale_pt_BR = `
     "weekdayNearestDayX0": "dia da semana mais próximo do dia %s",
     "commaEveryX0Years": ", a cada %s anos",
     "commaStartingX0": ", iniciando %s",
+    "atSystemStartup": "na inicialização do sistema",
+    "atAJobSpecificSecond": "em um segundo específico do job",
+    "atAJobSpecificMinute": "em um minuto específico do job",
+    "atAJobSpecificHour": "em uma hora específica do job",
+    "commaOnAJobSpecificDayOfTheMonth": ", em um dia do mês específico do job",
+    "commaInAJobSpecificMonth": ", em um mês específico do job",
+    "commaOnAJobSpecificDayOfTheWeek": ", em um dia da semana específico do job",
+    "commaStartingAtAJobSpecificOffset": ", começando em um deslocamento específico do job",
+    "everyDay": "a cada dia",
+    "everyX0Days": "a cada %s dias",
     "daysOfTheWeek": [
         "domingo",
         "segunda-feira",
@@ -77,3 +87,7 @@ const Locale_pt_BR = `
     ]
 }
 `
+
+func init() {
+	register("pt_BR", Locale_pt_BR)
+}
@@ -52,6 +52,16 @@ const Locale_ro = `
     "atX0MinutesPastTheHourGt20": "la și %s de minute",
     "atX0SecondsPastTheMinuteGt20": "la și %s de secunde",
     "commaStartingX0": ", pornire %s",
+    "atSystemStartup": "la pornirea sistemului",
+    "atAJobSpecificSecond": "la o secundă specifică sarcinii",
+    "atAJobSpecificMinute": "la un minut specific sarcinii",
+    "atAJobSpecificHour": "la o oră specifică sarcinii",
+    "commaOnAJobSpecificDayOfTheMonth": ", într-o zi a lunii specifică sarcinii",
+    "commaInAJobSpecificMonth": ", într-o lună specifică sarcinii",
+    "commaOnAJobSpecificDayOfTheWeek": ", într-o zi a săptămânii specifică sarcinii",
+    "commaStartingAtAJobSpecificOffset": ", începând de la un decalaj specific sarcinii",
+    "everyDay": "în fiecare zi",
+    "everyX0Days": "la fiecare %s zile",
     "daysOfTheWeek": [
         "duminică",
         "luni",
@@ -77,3 +87,7 @@ const Locale_ro = `
     ]
 }
 `
+
+func init() {
+	register("ro", Locale_ro)
+}
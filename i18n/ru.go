@@ -16,17 +16,47 @@ const Locale_ru = `
     "at": "В",
     "spaceAnd": " и",
     "everySecond": "каждую секунду",
-    "everyX0Seconds": "каждые %s секунд",
+    "everyX0Seconds": {
+        "one": "каждую %s секунду",
+        "few": "каждые %s секунды",
+        "many": "каждые %s секунд",
+        "other": "каждые %s секунд"
+    },
     "secondsX0ThroughX1PastTheMinute": "секунды с %s по %s",
-    "atX0SecondsPastTheMinute": "в %s секунд",
-    "everyX0Minutes": "каждые %s минут",
+    "atX0SecondsPastTheMinute": {
+        "one": "в %s секунду",
+        "few": "в %s секунды",
+        "many": "в %s секунд",
+        "other": "в %s секунд"
+    },
+    "everyX0Minutes": {
+        "one": "каждую %s минуту",
+        "few": "каждые %s минуты",
+        "many": "каждые %s минут",
+        "other": "каждые %s минут"
+    },
     "minutesX0ThroughX1PastTheHour": "минуты с %s по %s",
-    "atX0MinutesPastTheHour": "в %s минут",
-    "everyX0Hours": "каждые %s часов",
+    "atX0MinutesPastTheHour": {
+        "one": "в %s минуту",
+        "few": "в %s минуты",
+        "many": "в %s минут",
+        "other": "в %s минут"
+    },
+    "everyX0Hours": {
+        "one": "каждый %s час",
+        "few": "каждые %s часа",
+        "many": "каждые %s часов",
+        "other": "каждые %s часов"
+    },
     "betweenX0AndX1": "с %s по %s",
     "atX0": "в %s",
     "commaEveryDay": ", каждый день",
-    "commaEveryX0DaysOfTheWeek": ", каждые %s дней недели",
+    "commaEveryX0DaysOfTheWeek": {
+        "one": ", каждый %s день недели",
+        "few": ", каждые %s дня недели",
+        "many": ", каждые %s дней недели",
+        "other": ", каждые %s дней недели"
+    },
     "commaX0ThroughX1": ", %s по %s",
     "first": "первый",
     "second": "второй",
@@ -39,19 +69,54 @@ const Locale_ru = `
     "commaOnTheLastX0OfTheMonth": ", в последний %s месяца",
     "commaOnlyOnX0": ", только в %s",
     "commaAndOnX0": ", и в %s",
-    "commaEveryX0Months": ", каждые %s месяцев",
+    "commaEveryX0Months": {
+        "one": ", каждый %s месяц",
+        "few": ", каждые %s месяца",
+        "many": ", каждые %s месяцев",
+        "other": ", каждые %s месяцев"
+    },
     "commaOnlyInX0": ", только в %s",
     "commaOnTheLastDayOfTheMonth": ", в последний день месяца",
     "commaOnTheLastWeekdayOfTheMonth": ", в последний будний день месяца",
-    "commaDaysBeforeTheLastDayOfTheMonth": ", %s дней до последнего дня месяца",
+    "commaDaysBeforeTheLastDayOfTheMonth": {
+        "one": ", %s день до последнего дня месяца",
+        "few": ", %s дня до последнего дня месяца",
+        "many": ", %s дней до последнего дня месяца",
+        "other": ", %s дней до последнего дня месяца"
+    },
     "firstWeekday": "первый будний день",
     "weekdayNearestDayX0": "ближайший будний день к %s",
     "commaOnTheX0OfTheMonth": ", в %s месяца",
-    "commaEveryX0Days": ", каждые %s дней",
+    "commaEveryX0Days": {
+        "one": ", каждый %s день",
+        "few": ", каждые %s дня",
+        "many": ", каждые %s дней",
+        "other": ", каждые %s дней"
+    },
     "commaBetweenDayX0AndX1OfTheMonth": ", с %s по %s число месяца",
     "commaOnDayX0OfTheMonth": ", в %s число месяца",
-    "commaEveryX0Years": ", каждые %s лет",
+    "commaEveryX0Years": {
+        "one": ", каждый %s год",
+        "few": ", каждые %s года",
+        "many": ", каждые %s лет",
+        "other": ", каждые %s лет"
+    },
     "commaStartingX0": ", начало %s",
+    "atSystemStartup": "при запуске системы",
+    "atAJobSpecificSecond": "в секунду, определяемую заданием",
+    "atAJobSpecificMinute": "в минуту, определяемую заданием",
+    "atAJobSpecificHour": "в час, определяемый заданием",
+    "commaOnAJobSpecificDayOfTheMonth": ", в день месяца, определяемый заданием",
+    "commaInAJobSpecificMonth": ", в месяц, определяемый заданием",
+    "commaOnAJobSpecificDayOfTheWeek": ", в день недели, определяемый заданием",
+    "commaStartingAtAJobSpecificOffset": ", начиная со смещения, определяемого заданием",
+    "everyDay": "каждый день",
+    "everyX0Days": {
+        "one": "каждый %s день",
+        "few": "каждые %s дня",
+        "many": "каждые %s дней",
+        "other": "каждые %s дней"
+    },
     "daysOfTheWeek": [
         "воскресенье",
         "понедельник",
@@ -76,3 +141,7 @@ const Locale_ru = `
         "декабрь"
     ]
 }`
+
+func init() {
+	register("ru", Locale_ru)
+}
@@ -16,17 +16,41 @@ const Locale_sk = `
     "at": "V",
     "spaceAnd": " a",
     "everySecond": "každú sekundu",
-    "everyX0Seconds": "každých %s sekúnd",
+    "everyX0Seconds": {
+        "one": "každú %s sekundu",
+        "few": "každé %s sekundy",
+        "other": "každých %s sekúnd"
+    },
     "secondsX0ThroughX1PastTheMinute": "sekundy od %s do %s",
-    "atX0SecondsPastTheMinute": "v %s sekúnd",
-    "everyX0Minutes": "každých %s minút",
+    "atX0SecondsPastTheMinute": {
+        "one": "v %s sekundu",
+        "few": "v %s sekundy",
+        "other": "v %s sekúnd"
+    },
+    "everyX0Minutes": {
+        "one": "každú %s minútu",
+        "few": "každé %s minúty",
+        "other": "každých %s minút"
+    },
     "minutesX0ThroughX1PastTheHour": "minúty od %s do %s",
-    "atX0MinutesPastTheHour": "v %s minút",
-    "everyX0Hours": "každých %s hodín",
+    "atX0MinutesPastTheHour": {
+        "one": "v %s minútu",
+        "few": "v %s minúty",
+        "other": "v %s minút"
+    },
+    "everyX0Hours": {
+        "one": "každú %s hodinu",
+        "few": "každé %s hodiny",
+        "other": "každých %s hodín"
+    },
     "betweenX0AndX1": "medzi %s a %s",
     "atX0": "v %s",
     "commaEveryDay": ", každý deň",
-    "commaEveryX0DaysOfTheWeek": ", každých %s dní v týždni",
+    "commaEveryX0DaysOfTheWeek": {
+        "one": ", každý %s deň v týždni",
+        "few": ", každé %s dni v týždni",
+        "other": ", každých %s dní v týždni"
+    },
     "commaX0ThroughX1": ", od %s do %s",
     "first": "prvý",
     "second": "druhý",
@@ -39,19 +63,49 @@ const Locale_sk = `
     "commaOnTheLastX0OfTheMonth": ", posledný %s v mesiaci",
     "commaOnlyOnX0": ", iba v %s",
     "commaAndOnX0": ", a v %s",
-    "commaEveryX0Months": ", každých %s mesiacov",
+    "commaEveryX0Months": {
+        "one": ", každý %s mesiac",
+        "few": ", každé %s mesiace",
+        "other": ", každých %s mesiacov"
+    },
     "commaOnlyInX0": ", iba v %s",
     "commaOnTheLastDayOfTheMonth": ", posledný deň v mesiaci",
     "commaOnTheLastWeekdayOfTheMonth": ", posledný pracovný deň v mesiaci",
-    "commaDaysBeforeTheLastDayOfTheMonth": ", %s dní pred posledným dňom v mesiaci",
+    "commaDaysBeforeTheLastDayOfTheMonth": {
+        "one": ", %s deň pred posledným dňom v mesiaci",
+        "few": ", %s dni pred posledným dňom v mesiaci",
+        "other": ", %s dní pred posledným dňom v mesiaci"
+    },
     "firstWeekday": "prvý pracovný deň",
     "weekdayNearestDayX0": "pracovný deň najbližšie %s. dňu",
     "commaOnTheX0OfTheMonth": ", v %s v mesiaci",
-    "commaEveryX0Days": ", každých %s dní",
+    "commaEveryX0Days": {
+        "one": ", každý %s deň",
+        "few": ", každé %s dni",
+        "other": ", každých %s dní"
+    },
     "commaBetweenDayX0AndX1OfTheMonth": ", medzi dňami %s a %s v mesiaci",
     "commaOnDayX0OfTheMonth": ", %s. deň v mesiaci",
-    "commaEveryX0Years": ", každých %s rokov",
+    "commaEveryX0Years": {
+        "one": ", každý %s rok",
+        "few": ", každé %s roky",
+        "other": ", každých %s rokov"
+    },
     "commaStartingX0": ", začínajúcich %s",
+    "atSystemStartup": "pri spustení systému",
+    "atAJobSpecificSecond": "v sekunde špecifickej pre úlohu",
+    "atAJobSpecificMinute": "v minúte špecifickej pre úlohu",
+    "atAJobSpecificHour": "v hodine špecifickej pre úlohu",
+    "commaOnAJobSpecificDayOfTheMonth": ", v deň mesiaca špecifický pre úlohu",
+    "commaInAJobSpecificMonth": ", v mesiaci špecifickom pre úlohu",
+    "commaOnAJobSpecificDayOfTheWeek": ", v deň týždňa špecifický pre úlohu",
+    "commaStartingAtAJobSpecificOffset": ", počnúc posunom špecifickým pre úlohu",
+    "everyDay": "každý deň",
+    "everyX0Days": {
+        "one": "každý %s deň",
+        "few": "každé %s dni",
+        "other": "každých %s dní"
+    },
     "daysOfTheWeek": [
         "Nedeľa",
         "Pondelok",
@@ -77,3 +131,7 @@ const Locale_sk = `
     ]
 }
 `
+
+func init() {
+	register("sk", Locale_sk)
+}
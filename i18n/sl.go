@@ -13,18 +13,43 @@ const Locale_sl = `
     "betweenX0AndX1": "od %s do %s",
     "commaBetweenDayX0AndX1OfTheMonth": ", od %s. do %s. dne v mesecu",
     "commaEveryDay": ", vsak dan",
-    "commaEveryX0Days": ", vsakih %s dni",
-    "commaEveryX0DaysOfTheWeek": ", vsakih %s dni v tednu",
-    "commaEveryX0Months": ", vsakih %s mesecev",
-    "commaEveryX0Years": ", vsakih %s let",
+    "commaEveryX0Days": {
+        "one": ", vsak %s dan",
+        "two": ", vsaka %s dneva",
+        "few": ", vsake %s dni",
+        "other": ", vsakih %s dni"
+    },
+    "commaEveryX0DaysOfTheWeek": {
+        "one": ", vsak %s dan v tednu",
+        "two": ", vsaka %s dneva v tednu",
+        "few": ", vsake %s dni v tednu",
+        "other": ", vsakih %s dni v tednu"
+    },
+    "commaEveryX0Months": {
+        "one": ", vsak %s mesec",
+        "two": ", vsaka %s meseca",
+        "few": ", vsake %s mesece",
+        "other": ", vsakih %s mesecev"
+    },
+    "commaEveryX0Years": {
+        "one": ", vsako %s leto",
+        "two": ", vsaki %s leti",
+        "few": ", vsaka %s leta",
+        "other": ", vsakih %s let"
+    },
     "commaOnDayX0OfTheMonth": ", %s. dan v mesecu",
     "commaOnlyInX0": ", samo v %s",
     "commaOnlyOnX0": ", samo v %s",
     "commaAndOnX0": "in naprej %s",
     "commaOnThe": ", ",
-    "commaOnTheLastDayOfTheMonth": ", zadnji %s v mesecu",
+    "commaOnTheLastDayOfTheMonth": ", zadnji dan v mesecu",
     "commaOnTheLastWeekdayOfTheMonth": ", zadnji delovni dan v mesecu",
-    "commaDaysBeforeTheLastDayOfTheMonth": ", %s dni pred koncem meseca",
+    "commaDaysBeforeTheLastDayOfTheMonth": {
+        "one": ", %s dan pred koncem meseca",
+        "two": ", %s dneva pred koncem meseca",
+        "few": ", %s dnevi pred koncem meseca",
+        "other": ", %s dni pred koncem meseca"
+    },
     "commaOnTheLastX0OfTheMonth": ", zadnji %s v mesecu",
     "commaOnTheX0OfTheMonth": ", %s v mesecu",
     "commaX0ThroughX1": ", od %s do %s",
@@ -32,9 +57,24 @@ const Locale_sl = `
     "everyMinute": "vsako minuto",
     "everyMinuteBetweenX0AndX1": "Vsako minuto od %s do %s",
     "everySecond": "vsako sekundo",
-    "everyX0Hours": "vsakih %s ur",
-    "everyX0Minutes": "vsakih %s minut",
-    "everyX0Seconds": "vsakih %s sekund",
+    "everyX0Hours": {
+        "one": "vsako %s uro",
+        "two": "vsaki %s uri",
+        "few": "vsake %s ure",
+        "other": "vsakih %s ur"
+    },
+    "everyX0Minutes": {
+        "one": "vsako %s minuto",
+        "two": "vsaki %s minuti",
+        "few": "vsake %s minute",
+        "other": "vsakih %s minut"
+    },
+    "everyX0Seconds": {
+        "one": "vsako %s sekundo",
+        "two": "vsaki %s sekundi",
+        "few": "vsake %s sekunde",
+        "other": "vsakih %s sekund"
+    },
     "fifth": "peti",
     "first": "prvi",
     "firstWeekday": "prvi delovni dan",
@@ -52,6 +92,21 @@ const Locale_sl = `
     "atX0MinutesPastTheHourGt20": "",
     "atX0SecondsPastTheMinuteGt20": "",
     "commaStartingX0": ", začenši %s",
+    "atSystemStartup": "ob zagonu sistema",
+    "atAJobSpecificSecond": "ob sekundi, značilni za opravilo",
+    "atAJobSpecificMinute": "ob minuti, značilni za opravilo",
+    "atAJobSpecificHour": "ob uri, značilni za opravilo",
+    "commaOnAJobSpecificDayOfTheMonth": ", na dan v mesecu, značilen za opravilo",
+    "commaInAJobSpecificMonth": ", v mesecu, značilnem za opravilo",
+    "commaOnAJobSpecificDayOfTheWeek": ", na dan v tednu, značilen za opravilo",
+    "commaStartingAtAJobSpecificOffset": ", z začetkom ob zamiku, značilnem za opravilo",
+    "everyDay": "vsak dan",
+    "everyX0Days": {
+        "one": "vsak %s dan",
+        "two": "vsaka %s dneva",
+        "few": "vsake %s dni",
+        "other": "vsakih %s dni"
+    },
     "daysOfTheWeek": [
         "Nedelja",
         "Ponedeljek",
@@ -77,3 +132,7 @@ const Locale_sl = `
     ]
 }
 `
+
+func init() {
+	register("sl", Locale_sl)
+}
@@ -52,6 +52,20 @@ const Locale_sv = `
     "commaOnDayX0OfTheMonth": ", på dag %s av månaden",
     "commaEveryX0Years": ", var %s år",
     "commaStartingX0": ", startar %s",
+    "atSystemStartup": "vid systemstart",
+    "atAJobSpecificSecond": "vid en jobbspecifik sekund",
+    "atAJobSpecificMinute": "vid en jobbspecifik minut",
+    "atAJobSpecificHour": "vid en jobbspecifik timme",
+    "commaOnAJobSpecificDayOfTheMonth": ", på en jobbspecifik dag i månaden",
+    "commaInAJobSpecificMonth": ", i en jobbspecifik månad",
+    "commaOnAJobSpecificDayOfTheWeek": ", på en jobbspecifik veckodag",
+    "commaStartingAtAJobSpecificOffset": ", med start vid en jobbspecifik förskjutning",
+    "everyDay": "varje dag",
+    "everyX0Days": "var %s dag",
+    "ordinalX0": {
+        "one": "%s:a",
+        "other": "%s:e"
+    },
     "daysOfTheWeek": [
         "söndag",
         "måndag",
@@ -77,3 +91,7 @@ const Locale_sv = `
     ]
 }
 `
+
+func init() {
+	register("sv", Locale_sv)
+}
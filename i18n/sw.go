@@ -52,6 +52,16 @@ const Locale_sw = `
     "commaOnDayX0OfTheMonth": ", siku ya %s ya mwezi",
     "commaEveryX0Years": ", kila miaka %s",
     "commaStartingX0": ", kwanzia %s",
+    "atSystemStartup": "wakati mfumo unapowashwa",
+    "atAJobSpecificSecond": "katika sekunde maalum ya kazi",
+    "atAJobSpecificMinute": "katika dakika maalum ya kazi",
+    "atAJobSpecificHour": "katika saa maalum ya kazi",
+    "commaOnAJobSpecificDayOfTheMonth": ", katika siku maalum ya kazi ya mwezi",
+    "commaInAJobSpecificMonth": ", katika mwezi maalum wa kazi",
+    "commaOnAJobSpecificDayOfTheWeek": ", katika siku maalum ya kazi ya wiki",
+    "commaStartingAtAJobSpecificOffset": ", kuanzia kwenye mkengeuko maalum wa kazi",
+    "everyDay": "kila siku",
+    "everyX0Days": "kila siku %s",
     "daysOfTheWeek": [
         "Jumapili",
         "Jumatatu",
@@ -77,3 +87,7 @@ const Locale_sw = `
     ]
 }
 `
+
+func init() {
+	register("sw", Locale_sw)
+}
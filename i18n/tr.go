@@ -52,6 +52,16 @@ const Locale_tr = `
     "commaOnDayX0OfTheMonth": ", ayın %s. günü",
     "commaEveryX0Years": ", %s yılda bir",
     "commaStartingX0": ", başlangıç %s",
+    "atSystemStartup": "sistem başlangıcında",
+    "atAJobSpecificSecond": "işe özgü bir saniyede",
+    "atAJobSpecificMinute": "işe özgü bir dakikada",
+    "atAJobSpecificHour": "işe özgü bir saatte",
+    "commaOnAJobSpecificDayOfTheMonth": ", ayın işe özgü bir gününde",
+    "commaInAJobSpecificMonth": ", işe özgü bir ayda",
+    "commaOnAJobSpecificDayOfTheWeek": ", haftanın işe özgü bir gününde",
+    "commaStartingAtAJobSpecificOffset": ", işe özgü bir kaymadan başlayarak",
+    "everyDay": "her gün",
+    "everyX0Days": "%s günde bir",
     "daysOfTheWeek": [
         "Pazar",
         "Pazartesi",
@@ -77,3 +87,7 @@ const Locale_tr = `
     ]
 }
 `
+
+func init() {
+	register("tr", Locale_tr)
+}
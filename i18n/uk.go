@@ -16,17 +16,37 @@ const Locale_uk = `
     "at": "О",
     "spaceAnd": " та",
     "everySecond": "Щосекунди",
-    "everyX0Seconds": "кожні %s секунд",
+    "everyX0Seconds": {
+        "one": "кожну %s секунду",
+        "few": "кожні %s секунди",
+        "many": "кожні %s секунд",
+        "other": "кожні %s секунд"
+    },
     "secondsX0ThroughX1PastTheMinute": "з %s по %s секунду",
     "atX0SecondsPastTheMinute": "о %s секунді",
-    "everyX0Minutes": "кожні %s хвилин",
+    "everyX0Minutes": {
+        "one": "кожну %s хвилину",
+        "few": "кожні %s хвилини",
+        "many": "кожні %s хвилин",
+        "other": "кожні %s хвилин"
+    },
     "minutesX0ThroughX1PastTheHour": "з %s по %s хвилину",
     "atX0MinutesPastTheHour": "о %s хвилині",
-    "everyX0Hours": "кожні %s годин",
+    "everyX0Hours": {
+        "one": "кожну %s годину",
+        "few": "кожні %s години",
+        "many": "кожні %s годин",
+        "other": "кожні %s годин"
+    },
     "betweenX0AndX1": "між %s та %s",
     "atX0": "о %s",
     "commaEveryDay": ", щоденно",
-    "commaEveryX0DaysOfTheWeek": ", кожен %s день тижня",
+    "commaEveryX0DaysOfTheWeek": {
+        "one": ", кожен %s день тижня",
+        "few": ", кожні %s дні тижня",
+        "many": ", кожні %s днів тижня",
+        "other": ", кожні %s днів тижня"
+    },
     "commaX0ThroughX1": ", %s по %s",
     "first": "перший",
     "second": "другий",
@@ -39,19 +59,54 @@ const Locale_uk = `
     "commaOnTheLastX0OfTheMonth": ", в останній %s місяця",
     "commaOnlyOnX0": ", тільки в %s",
     "commaAndOnX0": ", і в %s",
-    "commaEveryX0Months": ", кожен %s місяць",
+    "commaEveryX0Months": {
+        "one": ", кожен %s місяць",
+        "few": ", кожні %s місяці",
+        "many": ", кожні %s місяців",
+        "other": ", кожні %s місяців"
+    },
     "commaOnlyInX0": ", тільки в %s",
     "commaOnTheLastDayOfTheMonth": ", в останній день місяця",
     "commaOnTheLastWeekdayOfTheMonth": ", в останній будень місяця",
-    "commaDaysBeforeTheLastDayOfTheMonth": ", %s днів до останнього дня місяця",
+    "commaDaysBeforeTheLastDayOfTheMonth": {
+        "one": ", %s день до останнього дня місяця",
+        "few": ", %s дні до останнього дня місяця",
+        "many": ", %s днів до останнього дня місяця",
+        "other": ", %s днів до останнього дня місяця"
+    },
     "firstWeekday": "перший будень",
     "weekdayNearestDayX0": "будень найближчий до %s дня",
     "commaOnTheX0OfTheMonth": ", в %s місяця",
-    "commaEveryX0Days": ", кожен %s день",
+    "commaEveryX0Days": {
+        "one": ", кожен %s день",
+        "few": ", кожні %s дні",
+        "many": ", кожні %s днів",
+        "other": ", кожні %s днів"
+    },
     "commaBetweenDayX0AndX1OfTheMonth": ", між %s та %s днями місяця",
     "commaOnDayX0OfTheMonth": ", на %s день місяця",
-    "commaEveryX0Years": ", кожні %s роки",
+    "commaEveryX0Years": {
+        "one": ", кожен %s рік",
+        "few": ", кожні %s роки",
+        "many": ", кожні %s років",
+        "other": ", кожні %s років"
+    },
     "commaStartingX0": ", початок %s",
+    "atSystemStartup": "під час запуску системи",
+    "atAJobSpecificSecond": "у секунду, визначену завданням",
+    "atAJobSpecificMinute": "у хвилину, визначену завданням",
+    "atAJobSpecificHour": "о годині, визначеній завданням",
+    "commaOnAJobSpecificDayOfTheMonth": ", у день місяця, визначений завданням",
+    "commaInAJobSpecificMonth": ", у місяць, визначений завданням",
+    "commaOnAJobSpecificDayOfTheWeek": ", у день тижня, визначений завданням",
+    "commaStartingAtAJobSpecificOffset": ", починаючи зі зсуву, визначеного завданням",
+    "everyDay": "щоденно",
+    "everyX0Days": {
+        "one": "кожен %s день",
+        "few": "кожні %s дні",
+        "many": "кожні %s днів",
+        "other": "кожні %s днів"
+    },
     "daysOfTheWeek": [
         "неділя",
         "понеділок",
@@ -76,3 +131,7 @@ const Locale_uk = `
         "грудень"
     ]
 }`
+
+func init() {
+	register("uk", Locale_uk)
+}
@@ -57,6 +57,16 @@ const Locale_zh_CN = `
     "commaEveryX0Years": ", 每隔 %s 年",
     "commaStartingX0": ", %s开始",
     "dayX0": " %s 号",
+    "atSystemStartup": "在系统启动时",
+    "atAJobSpecificSecond": "在作业特定的秒",
+    "atAJobSpecificMinute": "在作业特定的分钟",
+    "atAJobSpecificHour": "在作业特定的小时",
+    "commaOnAJobSpecificDayOfTheMonth": ", 在作业特定的日期",
+    "commaInAJobSpecificMonth": ", 在作业特定的月份",
+    "commaOnAJobSpecificDayOfTheWeek": ", 在作业特定的星期几",
+    "commaStartingAtAJobSpecificOffset": ", 从作业特定的偏移开始",
+    "everyDay": "每天",
+    "everyX0Days": "每隔 %s 天",
     "daysOfTheWeek": [
         "星期日",
         "星期一",
@@ -82,3 +92,7 @@ const Locale_zh_CN = `
     ]
 }
 `
+
+func init() {
+	register("zh_CN", Locale_zh_CN)
+}
@@ -55,6 +55,16 @@ const Locale_zh_TW = `
     "commaEveryX0Years": ", 每 %s 年",
     "commaStartingX0": ", %s 開始",
     "dayX0": " %s 號",
+    "atSystemStartup": "在系統啟動時",
+    "atAJobSpecificSecond": "在作業特定的秒",
+    "atAJobSpecificMinute": "在作業特定的分鐘",
+    "atAJobSpecificHour": "在作業特定的小時",
+    "commaOnAJobSpecificDayOfTheMonth": ", 在作業特定的日期",
+    "commaInAJobSpecificMonth": ", 在作業特定的月份",
+    "commaOnAJobSpecificDayOfTheWeek": ", 在作業特定的星期幾",
+    "commaStartingAtAJobSpecificOffset": ", 從作業特定的偏移開始",
+    "everyDay": "每天",
+    "everyX0Days": "每 %s 天",
     "daysOfTheWeek": [
         "星期日",
         "星期一",
@@ -80,3 +90,7 @@ const Locale_zh_TW = `
     ]
 }
 `
+
+func init() {
+	register("zh_TW", Locale_zh_TW)
+}
@@ -0,0 +1,106 @@
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// FieldLayout is the layout of the fields of the CRON expressions, which tells the parser whether
+// the first part is a second and whether the last part is a year.
+type FieldLayout int
+
+const (
+	// LayoutAuto uses the layout of the dialect, or guesses the layout from the number of parts for DialectDefault.
+	// 6 part expressions are guessed to have a year (last part) if the last part is a 4-digit year,
+	// a second (first part) otherwise, i.e. "0 0 12 * * 2030" has a year but "0 0 12 * * *" has a second.
+	LayoutAuto FieldLayout = iota
+	// LayoutFiveFields is "minute hour day-of-month month day-of-week".
+	LayoutFiveFields
+	// LayoutSecondsFirst is "second minute hour day-of-month month day-of-week".
+	LayoutSecondsFirst
+	// LayoutYearLast is "minute hour day-of-month month day-of-week year".
+	LayoutYearLast
+	// LayoutSevenFields is "second minute hour day-of-month month day-of-week year".
+	LayoutSevenFields
+)
+
+var (
+	layoutNames = map[FieldLayout]string{
+		LayoutAuto:         "auto",
+		LayoutFiveFields:   "5-field",
+		LayoutSecondsFirst: "seconds-first",
+		LayoutYearLast:     "year-last",
+		LayoutSevenFields:  "7-field",
+	}
+)
+
+func (l FieldLayout) String() string {
+	if name, ok := layoutNames[l]; ok {
+		return name
+	}
+	return "FieldLayout(" + strconv.Itoa(int(l)) + ")"
+}
+
+// ParseFieldLayout returns the field layout by its name (case insensitive), i.e. "seconds-first" or "year-last".
+func ParseFieldLayout(s string) (FieldLayout, error) {
+	for layout, name := range layoutNames {
+		if strings.EqualFold(s, name) {
+			return layout, nil
+		}
+	}
+	return LayoutAuto, fmt.Errorf("unknown field layout %s", s)
+}
+
+// fields returns the types of the fields of the layout, in order.
+func (l FieldLayout) fields() []FieldType {
+	all := []FieldType{FieldSecond, FieldMinute, FieldHour, FieldDayOfMonth, FieldMonth, FieldDayOfWeek, FieldYear}
+	switch l {
+	case LayoutFiveFields:
+		return all[1:6]
+	case LayoutSecondsFirst:
+		return all[:6]
+	case LayoutYearLast:
+		return all[1:]
+	case LayoutSevenFields:
+		return all
+	}
+	return nil
+}
+
+// pickLayout returns the first of the layouts which has the second and the year if they're specified,
+// or the first layout if none has them.
+func pickLayout(layouts []FieldLayout, hasSecond, hasYear bool) FieldLayout {
+	for _, layout := range layouts {
+		fields := layout.fields()
+		if (!hasSecond || fields[0] == FieldSecond) && (!hasYear || fields[len(fields)-1] == FieldYear) {
+			return layout
+		}
+	}
+	return layouts[0]
+}
+
+// describe returns the number of parts and the fields of the layout, i.e. "5 parts (minute, hour, day of month, month, day of week)".
+func (l FieldLayout) describe() string {
+	names := make([]string, 0, 7)
+	for _, field := range l.fields() {
+		names = append(names, field.String())
+	}
+	return fmt.Sprintf("%d parts (%s)", len(names), strings.Join(names, ", "))
+}
+
+// placeParts places the parts of the CRON expression in the 7-part-CRON format, using the first of the layouts
+// which has the same number of parts. source tells where the layouts come from in the error message.
+func placeParts(parts []string, offsets []int, layouts []FieldLayout, source string, tokens *exprTokens) error {
+	descs := make([]string, 0, len(layouts))
+	for _, layout := range layouts {
+		fields := layout.fields()
+		if len(parts) == len(fields) {
+			copy(tokens.parts[fields[0]:], parts)
+			copy(tokens.offsets[fields[0]:], offsets)
+			return nil
+		}
+		descs = append(descs, layout.describe())
+	}
+	return fmt.Errorf("expression has %d parts, but %s requires %s: %w", len(parts), source, strings.Join(descs, " or "), InvalidExprError)
+}
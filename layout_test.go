@@ -0,0 +1,87 @@
+package cron
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCronParser_FieldLayout(t *testing.T) {
+	type testCase struct {
+		name      string
+		inLayout  FieldLayout
+		inDialect Dialect
+		inExpr    string
+		outExprs  []string
+		outErr    string
+	}
+
+	tcs := []testCase{
+		{name: "should guess year", inLayout: LayoutAuto, inExpr: "0 0 12 * * 2030-2040", outExprs: []string{"", "0", "0", "12", "*", "*", "2030-2040"}},
+		{name: "should guess second", inLayout: LayoutAuto, inExpr: "0 0 12 * * *", outExprs: []string{"", "0", "12", "*", "*", "*", ""}},
+		{name: "should pin year last", inLayout: LayoutYearLast, inExpr: "0 0 12 * * *", outExprs: []string{"", "0", "0", "12", "*", "*", "*"}},
+		{name: "should pin year last with year step", inLayout: LayoutYearLast, inExpr: "0 12 * * * */2", outExprs: []string{"", "0", "12", "*", "*", "*", "*/2"}},
+		{name: "should pin seconds first", inLayout: LayoutSecondsFirst, inExpr: "30 0 12 * * 1", outExprs: []string{"30", "0", "12", "*", "*", "1", ""}},
+		{name: "should pin 5 fields", inLayout: LayoutFiveFields, inExpr: "0 12 * * 1", outExprs: []string{"", "0", "12", "*", "*", "1", ""}},
+		{name: "should pin 7 fields", inLayout: LayoutSevenFields, inExpr: "30 0 12 * * 1 2030", outExprs: []string{"30", "0", "12", "*", "*", "1", "2030"}},
+		{name: "should override layout of dialect", inLayout: LayoutSevenFields, inDialect: DialectQuartz, inExpr: "0 0 12 ? * 1 *", outExprs: []string{"", "0", "12", "*", "*", "0", "*"}},
+		{name: "should accept macro with any layout", inLayout: LayoutSevenFields, inExpr: "@daily", outExprs: []string{"", "0", "0", "*", "*", "*", ""}},
+		{
+			name:     "should fail on year last with 7 parts",
+			inLayout: LayoutYearLast,
+			inExpr:   "0 0 12 * * * 2030",
+			outErr:   "expression has 7 parts, but the year-last layout requires 6 parts (minute, hour, day of month, month, day of week, year)",
+		}, {
+			name:     "should fail on 5 fields with 6 parts",
+			inLayout: LayoutFiveFields,
+			inExpr:   "0 0 12 * * *",
+			outErr:   "expression has 6 parts, but the 5-field layout requires 5 parts (minute, hour, day of month, month, day of week)",
+		}, {
+			name:      "should fail on Quartz with 5 parts",
+			inDialect: DialectQuartz,
+			inExpr:    "0 12 ? * 1",
+			outErr:    "expression has 5 parts, but the Quartz dialect requires 6 parts (second, minute, hour, day of month, month, day of week) or 7 parts (second, minute, hour, day of month, month, day of week, year)",
+		}, {
+			name:     "should fail on seconds first with year",
+			inLayout: LayoutSecondsFirst,
+			inExpr:   "0 12 * * * 2030",
+			outErr:   "DOW contains invalid values",
+		},
+	}
+
+	for i, tc := range tcs {
+		parser := NewParser(SetFieldLayout(tc.inLayout), SetDialect(tc.inDialect))
+
+		parsed, err := parser.Parse(tc.inExpr)
+		if tc.outErr != "" {
+			if !errors.Is(err, InvalidExprError) && !errors.As(err, new(*ParseError)) {
+				t.Errorf("%d. %s: expected invalid expression error, got '%v'", i, tc.name, err)
+				continue
+			}
+			if !strings.Contains(err.Error(), tc.outErr) {
+				t.Errorf("%d. %s: expected error containing '%s', got '%v'", i, tc.name, tc.outErr, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%d. %s: expected nil, got error '%v'", i, tc.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(parsed, tc.outExprs) {
+			t.Errorf("%d. %s: expected '%v', got '%v'", i, tc.name, tc.outExprs, parsed)
+		}
+	}
+}
+
+func TestParseFieldLayout(t *testing.T) {
+	for layout, name := range layoutNames {
+		got, err := ParseFieldLayout(name)
+		if err != nil || got != layout {
+			t.Errorf("%s: expected %v, got %v (%v)", name, layout, got, err)
+		}
+	}
+	if _, err := ParseFieldLayout("6-field"); err == nil {
+		t.Errorf("6-field: expected error, got nil")
+	}
+}
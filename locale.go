@@ -3,9 +3,8 @@ package cron
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
-
-	"github.com/lnquy/cron/i18n"
 )
 
 const (
@@ -39,37 +38,6 @@ const (
 	Locale_zh_TW LocaleType = "zh_TW"
 )
 
-var (
-	allLocales = []LocaleType{
-		Locale_cs,
-		Locale_da,
-		Locale_de,
-		Locale_en,
-		Locale_es,
-		Locale_fa,
-		Locale_fi,
-		Locale_fr,
-		Locale_he,
-		Locale_it,
-		Locale_ja,
-		Locale_ko,
-		Locale_nb,
-		Locale_nl,
-		Locale_pl,
-		Locale_pt_BR,
-		Locale_ro,
-		Locale_ru,
-		Locale_sk,
-		Locale_sl,
-		Locale_sv,
-		Locale_sw,
-		Locale_tr,
-		Locale_uk,
-		Locale_zh_CN,
-		Locale_zh_TW,
-	}
-)
-
 type (
 	LocaleType string
 	LocaleKey  string
@@ -80,7 +48,16 @@ type (
 		GetLocaleType() (typ LocaleType)
 		GetBool(key LocaleKey) (value bool)
 		GetString(key LocaleKey) (value string)
+		// GetPluralString returns the form of the string for the CLDR plural category of count (see PluralCategoryOf),
+		// i.e. "every %s minute" for 1 and "every %s minutes" for 5 in English.
+		GetPluralString(key LocaleKey, count int) (value string)
 		GetSlice(key LocaleKey) (values []string)
+		// GetParentLocaleType returns the type of the locale the missing or empty keys are resolved through,
+		// or "" if the locale has no parent. See DefaultParentLocale.
+		GetParentLocaleType() (typ LocaleType)
+		// GetOrdinal returns n as an ordinal number like in a date, i.e. "15th" in English, "15." in German
+		// or "1er" in French, or "" if the locale has no ordinal numbers.
+		GetOrdinal(n int) (value string)
 	}
 
 	// LocaleLoader holds the map of i18n strings in a specific language (localType).
@@ -103,64 +80,10 @@ func NewLocaleLoaders(types ...LocaleType) (loaders []Locale, err error) {
 }
 
 func newLocaleLoader(typ LocaleType) (loaders []Locale, err error) {
-	var rawData string
-	localeMap := make(map[string]interface{}, 60)
-	switch typ {
-	case Locale_cs:
-		rawData = i18n.Locale_cs
-	case Locale_da:
-		rawData = i18n.Locale_da
-	case Locale_de:
-		rawData = i18n.Locale_de
-	case Locale_en:
-		rawData = i18n.Locale_en
-	case Locale_es:
-		rawData = i18n.Locale_es
-	case Locale_fa:
-		rawData = i18n.Locale_fa
-	case Locale_fi:
-		rawData = i18n.Locale_fi
-	case Locale_fr:
-		rawData = i18n.Locale_fr
-	case Locale_he:
-		rawData = i18n.Locale_he
-	case Locale_it:
-		rawData = i18n.Locale_it
-	case Locale_ja:
-		rawData = i18n.Locale_ja
-	case Locale_ko:
-		rawData = i18n.Locale_ko
-	case Locale_nb:
-		rawData = i18n.Locale_nb
-	case Locale_nl:
-		rawData = i18n.Locale_nl
-	case Locale_pl:
-		rawData = i18n.Locale_pl
-	case Locale_pt_BR:
-		rawData = i18n.Locale_pt_BR
-	case Locale_ro:
-		rawData = i18n.Locale_ro
-	case Locale_ru:
-		rawData = i18n.Locale_ru
-	case Locale_sk:
-		rawData = i18n.Locale_sk
-	case Locale_sl:
-		rawData = i18n.Locale_sl
-	case Locale_sv:
-		rawData = i18n.Locale_sv
-	case Locale_sw:
-		rawData = i18n.Locale_sw
-	case Locale_tr:
-		rawData = i18n.Locale_tr
-	case Locale_uk:
-		rawData = i18n.Locale_uk
-	case Locale_zh_CN:
-		rawData = i18n.Locale_zh_CN
-	case Locale_zh_TW:
-		rawData = i18n.Locale_zh_TW
-	case LocaleAll:
-		loaders = make([]Locale, 0, len(allLocales))
-		for _, l := range allLocales {
+	if typ == LocaleAll {
+		types := RegisteredLocales()
+		loaders = make([]Locale, 0, len(types))
+		for _, l := range types {
 			got, err := newLocaleLoader(l)
 			if err != nil {
 				return nil, fmt.Errorf("failed to init locale loader for %s: %w", l, err)
@@ -168,11 +91,18 @@ func newLocaleLoader(typ LocaleType) (loaders []Locale, err error) {
 			loaders = append(loaders, got...)
 		}
 		return loaders, nil
-	default:
-		return nil, fmt.Errorf("unsupported locale: %s", typ)
 	}
 
-	// Load a single locale
+	locale, err := lookupLocale(typ)
+	if err != nil {
+		return nil, err
+	}
+	return []Locale{locale}, nil
+}
+
+// decodeLocale decodes the JSON document of a built-in locale (see the i18n package).
+func decodeLocale(typ LocaleType, rawData string) (locale Locale, err error) {
+	localeMap := make(map[string]interface{}, 60)
 	if err = json.Unmarshal([]byte(rawData), &localeMap); err != nil {
 		return nil, fmt.Errorf("failed to decode locale map, locale=%s: %w", typ, err)
 	}
@@ -189,10 +119,7 @@ func newLocaleLoader(typ LocaleType) (loaders []Locale, err error) {
 	localeMap[string(daysOfTheWeek)] = sld.DaysOfTheWeek
 	localeMap[string(monthsOfTheYear)] = sld.MonthsOfTheYear
 
-	loaders = []Locale{
-		&LocaleLoader{localeType: typ, data: localeMap},
-	}
-	return loaders, nil
+	return &LocaleLoader{localeType: typ, data: localeMap}, nil
 }
 
 func (l *LocaleLoader) GetLocaleType() (typ LocaleType) {
@@ -207,12 +134,31 @@ func (l *LocaleLoader) GetBool(key LocaleKey) (value bool) {
 	return casted
 }
 
+// GetString returns the string of the key, or its "other" plural form if the string has plural forms.
 func (l *LocaleLoader) GetString(key LocaleKey) (value string) {
-	casted, ok := l.data[string(key)].(string)
-	if !ok {
-		return ""
+	return l.getPluralForm(key, PluralOther)
+}
+
+// GetPluralString returns the plural form of the string for the count.
+// The plural forms of a string are an object of the CLDR plural categories, i.e.
+// {"one": "every %s minute", "other": "every %s minutes"}, "other" is used for the missing categories.
+// A string without plural forms is used for all the counts.
+func (l *LocaleLoader) GetPluralString(key LocaleKey, count int) (value string) {
+	return l.getPluralForm(key, PluralCategoryOf(l.localeType, count))
+}
+
+func (l *LocaleLoader) getPluralForm(key LocaleKey, category PluralCategory) string {
+	switch casted := l.data[string(key)].(type) {
+	case string:
+		return casted
+	case map[string]interface{}:
+		if form, ok := casted[string(category)].(string); ok {
+			return form
+		}
+		form, _ := casted[string(PluralOther)].(string)
+		return form
 	}
-	return casted
+	return ""
 }
 
 func (l *LocaleLoader) GetSlice(key LocaleKey) (values []string) {
@@ -223,6 +169,25 @@ func (l *LocaleLoader) GetSlice(key LocaleKey) (values []string) {
 	return casted
 }
 
+// GetOrdinal returns n formatted by the form of the "ordinalX0" string for the CLDR ordinal category of n
+// (see OrdinalCategoryOf), i.e. {"one": "%sst", "two": "%snd", "few": "%srd", "other": "%sth"} in English.
+func (l *LocaleLoader) GetOrdinal(n int) (value string) {
+	format := l.getPluralForm(ordinalX0, OrdinalCategoryOf(l.localeType, n))
+	if format == "" {
+		return ""
+	}
+	return sprintf(format, strconv.Itoa(n))
+}
+
+// GetParentLocaleType returns the "parentLocale" of the locale map ("" for no parent),
+// or the default parent of the locale type.
+func (l *LocaleLoader) GetParentLocaleType() (typ LocaleType) {
+	if parent, ok := l.data[string(parentLocale)].(string); ok {
+		return LocaleType(parent)
+	}
+	return DefaultParentLocale(l.localeType)
+}
+
 var (
 	// Config
 	confSetPeriodBeforeTime LocaleKey = "confSetPeriodBeforeTime"
@@ -283,63 +248,139 @@ var (
 	pm                                  LocaleKey = "pm"
 	am                                  LocaleKey = "am"
 	commaOnlyInYearX0                   LocaleKey = "commaOnlyInYearX0"
+	atSystemStartup                     LocaleKey = "atSystemStartup"
+	atAJobSpecificSecond                LocaleKey = "atAJobSpecificSecond"
+	atAJobSpecificMinute                LocaleKey = "atAJobSpecificMinute"
+	atAJobSpecificHour                  LocaleKey = "atAJobSpecificHour"
+	commaOnAJobSpecificDayOfTheMonth    LocaleKey = "commaOnAJobSpecificDayOfTheMonth"
+	commaInAJobSpecificMonth            LocaleKey = "commaInAJobSpecificMonth"
+	commaOnAJobSpecificDayOfTheWeek     LocaleKey = "commaOnAJobSpecificDayOfTheWeek"
+	commaStartingAtAJobSpecificOffset   LocaleKey = "commaStartingAtAJobSpecificOffset"
+	everyDay                            LocaleKey = "everyDay"
+	everyX0Days                         LocaleKey = "everyX0Days"
+	ordinalX0                           LocaleKey = "ordinalX0"
+	commaOnTheX0ToLastDayOfTheMonth     LocaleKey = "commaOnTheX0ToLastDayOfTheMonth"
+	noon                                LocaleKey = "noon"
+	midnight                            LocaleKey = "midnight"
+	spaceX0Day                          LocaleKey = "spaceX0Day"
+	digits                              LocaleKey = "digits"
+	parentLocale                        LocaleKey = "parentLocale"
 )
 
+var (
+	// localeKeys are all the keys of a locale, in the order they are declared.
+	localeKeys = []LocaleKey{
+		confSetPeriodBeforeTime,
+		everyMinute,
+		everyHour,
+		atSpace,
+		everyMinuteBetweenX0AndX1,
+		at,
+		spaceAnd,
+		everySecond,
+		everyX0Seconds,
+		secondsX0ThroughX1PastTheMinute,
+		atX0SecondsPastTheMinute,
+		everyX0Minutes,
+		minutesX0ThroughX1PastTheHour,
+		atX0MinutesPastTheHour,
+		everyX0Hours,
+		betweenX0AndX1,
+		atX0,
+		commaEveryDay,
+		commaEveryX0DaysOfTheWeek,
+		commaX0ThroughX1,
+		first,
+		second,
+		third,
+		fourth,
+		fifth,
+		commaOnThe,
+		spaceX0OfTheMonth,
+		lastDay,
+		commaOnTheLastX0OfTheMonth,
+		commaOnlyOnX0,
+		commaAndOnX0,
+		commaEveryX0Months,
+		commaOnlyInX0,
+		commaOnTheLastDayOfTheMonth,
+		commaOnTheLastWeekdayOfTheMonth,
+		commaDaysBeforeTheLastDayOfTheMonth,
+		firstWeekday,
+		weekdayNearestDayX0,
+		commaOnTheX0OfTheMonth,
+		commaEveryX0Days,
+		commaBetweenDayX0AndX1OfTheMonth,
+		commaOnDayX0OfTheMonth,
+		commaEveryHour,
+		commaEveryX0Years,
+		commaStartingX0,
+		daysOfTheWeek,
+		atX0SecondsPastTheMinuteGt20,
+		atX0MinutesPastTheHourGt20,
+		commaMonthX0ThroughMonthX1,
+		commaOnlyInMonthX0,
+		commaYearX0ThroughYearX1,
+		dayX0,
+		monthsOfTheYear,
+		pm,
+		am,
+		commaOnlyInYearX0,
+		atSystemStartup,
+		atAJobSpecificSecond,
+		atAJobSpecificMinute,
+		atAJobSpecificHour,
+		commaOnAJobSpecificDayOfTheMonth,
+		commaInAJobSpecificMonth,
+		commaOnAJobSpecificDayOfTheWeek,
+		commaStartingAtAJobSpecificOffset,
+		everyDay,
+		everyX0Days,
+		ordinalX0,
+		commaOnTheX0ToLastDayOfTheMonth,
+		noon,
+		midnight,
+		spaceX0Day,
+		digits,
+		parentLocale,
+	}
+
+	// optionalLocaleKeys are the keys a locale may leave out, the descriptions fall back to the other keys.
+	optionalLocaleKeys = map[LocaleKey]bool{
+		confSetPeriodBeforeTime:         true,
+		commaEveryHour:                  true,
+		atX0SecondsPastTheMinuteGt20:    true,
+		atX0MinutesPastTheHourGt20:      true,
+		commaMonthX0ThroughMonthX1:      true,
+		commaOnlyInMonthX0:              true,
+		commaYearX0ThroughYearX1:        true,
+		dayX0:                           true,
+		commaOnlyInYearX0:               true,
+		pm:                              true,
+		am:                              true,
+		ordinalX0:                       true,
+		commaOnTheX0ToLastDayOfTheMonth: true,
+		noon:                            true,
+		midnight:                        true,
+		spaceX0Day:                      true,
+		digits:                          true,
+		parentLocale:                    true,
+	}
+)
+
+// ParseLocale returns the registered locale type by its name (case insensitive), i.e. "pt_br" or "pt" for Locale_pt_BR,
+// or by the BCP 47 language tag, i.e. "zh-Hant-HK" for Locale_zh_TW or "de-CH" for Locale_de (see LocaleFallbacks).
+// See RegisterLocale to register a locale.
 func ParseLocale(s string) (l LocaleType, err error) {
-	switch strings.ToLower(s) {
-	case "cs":
-		return Locale_cs, nil
-	case "da":
-		return Locale_da, nil
-	case "de":
-		return Locale_de, nil
-	case "en":
-		return Locale_en, nil
-	case "es":
-		return Locale_es, nil
-	case "fa":
-		return Locale_fa, nil
-	case "fi":
-		return Locale_fi, nil
-	case "fr":
-		return Locale_fr, nil
-	case "he":
-		return Locale_he, nil
-	case "it":
-		return Locale_it, nil
-	case "ja":
-		return Locale_ja, nil
-	case "ko":
-		return Locale_ko, nil
-	case "nb":
-		return Locale_nb, nil
-	case "nl":
-		return Locale_nl, nil
-	case "pl":
-		return Locale_pl, nil
-	case "pt", "pt_br":
-		return Locale_pt_BR, nil
-	case "ro":
-		return Locale_ro, nil
-	case "ru":
-		return Locale_ru, nil
-	case "sk":
-		return Locale_sk, nil
-	case "sl":
-		return Locale_sl, nil
-	case "sv":
-		return Locale_sv, nil
-	case "sw":
-		return Locale_sw, nil
-	case "tr":
-		return Locale_tr, nil
-	case "uk":
-		return Locale_uk, nil
-	case "zh", "zh_cn":
-		return Locale_zh_CN, nil
-	case "zh_tw":
-		return Locale_zh_TW, nil
-	default:
-		return "", fmt.Errorf("unsupported locale: %s", s)
+	if typ, ok := findLocale(s); ok {
+		return typ, nil
+	}
+	index := make(map[string]LocaleType)
+	for _, typ := range RegisteredLocales() {
+		index[strings.ToLower(string(typ))] = typ
+	}
+	if typ, ok := negotiateLocale([]string{s}, index); ok {
+		return typ, nil
 	}
+	return "", fmt.Errorf("unsupported locale: %s", s)
 }
@@ -0,0 +1,271 @@
+package cron
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/lnquy/cron/i18n"
+)
+
+var (
+	englishLocaleOnce sync.Once
+	englishLocaleMap  map[string]interface{} // Decoded English locale, the reference of the placeholders
+)
+
+// LocaleIssueKind is the kind of a problem of a locale.
+type LocaleIssueKind string
+
+const (
+	LocaleIssueParse        LocaleIssueKind = "parse"        // The locale document fails to decode
+	LocaleIssueMissingKey   LocaleIssueKind = "missing-key"  // A required key is missing or empty
+	LocaleIssueUnknownKey   LocaleIssueKind = "unknown-key"  // A key is not a LocaleKey, i.e. a typo
+	LocaleIssueInvalidValue LocaleIssueKind = "invalid"      // A value has the wrong type, i.e. a number instead of a string
+	LocaleIssuePlaceholders LocaleIssueKind = "placeholders" // A string has not as many %s placeholders as in English
+	LocaleIssueLength       LocaleIssueKind = "length"       // daysOfTheWeek doesn't have 7 days or monthsOfTheYear 12 months
+)
+
+// LocaleIssue is a problem of a locale, which breaks or degrades its descriptions.
+type LocaleIssue struct {
+	Locale  LocaleType
+	Kind    LocaleIssueKind
+	Key     string // Key of the problem, empty if it's about the whole locale
+	Message string
+}
+
+func (i LocaleIssue) String() string {
+	if i.Key == "" {
+		return fmt.Sprintf("%s: %s: %s", i.Locale, i.Kind, i.Message)
+	}
+	return fmt.Sprintf("%s: %s: %s: %s", i.Locale, i.Kind, i.Key, i.Message)
+}
+
+// CheckLocales checks the registered locales of the types (or all the registered locales if no type is given)
+// for missing and unknown keys, values of the wrong type, strings which have not as many %s placeholders
+// as in English, days of the week and months of the year of the wrong length, and documents which fail to decode.
+// The issues are sorted by locale and key.
+//
+// The keys and documents are only checked for the built-in locales and the ones loaded from files,
+// a custom Locale implementation is checked through its methods.
+func CheckLocales(types ...LocaleType) ([]LocaleIssue, error) {
+	if len(types) == 0 {
+		types = RegisteredLocales()
+	}
+
+	var issues []LocaleIssue
+	for _, typ := range types {
+		localeRegistryMu.RLock()
+		registered, ok := localeRegistry[typ]
+		localeRegistryMu.RUnlock()
+		if !ok {
+			return nil, fmt.Errorf("unsupported locale: %s", typ)
+		}
+
+		if registered.data != "" {
+			issues = append(issues, checkLocaleDocument(typ, []byte(registered.data), json.Unmarshal)...)
+			continue
+		}
+		if loader, ok := registered.locale.(*LocaleLoader); ok {
+			issues = append(issues, checkLocaleMap(typ, loader.data)...)
+			continue
+		}
+		issues = append(issues, checkLocale(registered.locale)...)
+	}
+	sortLocaleIssues(issues)
+	return issues, nil
+}
+
+// CheckLocaleFile checks the locale of a JSON (.json) or YAML (.yaml, .yml) file like CheckLocales,
+// i.e. to check a translation before it's shipped. See LoadLocaleFromFile.
+func CheckLocaleFile(path string) ([]LocaleIssue, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read locale file: %w", err)
+	}
+
+	ext := filepath.Ext(path)
+	typ := LocaleType(strings.TrimSuffix(filepath.Base(path), ext))
+	var issues []LocaleIssue
+	switch strings.ToLower(ext) {
+	case ".json":
+		issues = checkLocaleDocument(typ, data, json.Unmarshal)
+	case ".yaml", ".yml":
+		issues = checkLocaleDocument(typ, data, unmarshalYAML)
+	default:
+		return nil, fmt.Errorf("unsupported locale file extension %s, must be .json, .yaml or .yml", ext)
+	}
+	sortLocaleIssues(issues)
+	return issues, nil
+}
+
+func checkLocaleDocument(typ LocaleType, data []byte, unmarshal func([]byte, interface{}) error) []LocaleIssue {
+	raw := make(map[string]interface{})
+	if err := unmarshal(data, &raw); err != nil {
+		return []LocaleIssue{{Locale: typ, Kind: LocaleIssueParse, Message: err.Error()}}
+	}
+	return checkLocaleMap(typ, raw)
+}
+
+// checkLocaleMap checks the keys and values of the decoded locale.
+func checkLocaleMap(typ LocaleType, raw map[string]interface{}) []LocaleIssue {
+	var issues []LocaleIssue
+	add := func(kind LocaleIssueKind, key string, format string, v ...interface{}) {
+		issues = append(issues, LocaleIssue{Locale: typ, Kind: kind, Key: key, Message: fmt.Sprintf(format, v...)})
+	}
+
+	parent, _ := raw[string(parentLocale)].(string)
+	known := make(map[string]bool, len(localeKeys))
+	for _, key := range localeKeys {
+		known[string(key)] = true
+		value, ok := raw[string(key)]
+		if !ok || value == "" {
+			if !optionalLocaleKeys[key] && parent == "" {
+				add(LocaleIssueMissingKey, string(key), "required key is missing or empty")
+			}
+			continue
+		}
+
+		switch key {
+		case confSetPeriodBeforeTime:
+			if _, ok := value.(bool); !ok {
+				add(LocaleIssueInvalidValue, string(key), "must be a boolean, got %v", value)
+			}
+		case parentLocale:
+			if _, ok := value.(string); !ok {
+				add(LocaleIssueInvalidValue, string(key), "must be a locale type, got %v", value)
+			}
+		case digits:
+			if v, ok := value.(string); !ok || !isDigitSet(v) {
+				add(LocaleIssueInvalidValue, string(key), "must be the 10 digits from 0 to 9, got %v", value)
+			}
+		case daysOfTheWeek, monthsOfTheYear:
+			values, ok := toStrings(value)
+			if !ok {
+				add(LocaleIssueInvalidValue, string(key), "must be a list of strings, got %v", value)
+				continue
+			}
+			if size := localeSliceSize(key); len(values) != size {
+				add(LocaleIssueLength, string(key), "must have %d items, got %d", size, len(values))
+			}
+		default:
+			if !isLocaleString(value) {
+				add(LocaleIssueInvalidValue, string(key), "must be a string or the plural forms of a string, got %v", value)
+				continue
+			}
+			issues = append(issues, checkPlaceholders(typ, key, localeForms(value))...)
+		}
+	}
+
+	var unknown []string
+	for key := range raw {
+		if !known[key] {
+			unknown = append(unknown, key)
+		}
+	}
+	sort.Strings(unknown)
+	for _, key := range unknown {
+		add(LocaleIssueUnknownKey, key, "not a key of a locale")
+	}
+	return issues
+}
+
+// checkLocale checks the locale through the methods of the Locale interface.
+func checkLocale(locale Locale) []LocaleIssue {
+	typ := locale.GetLocaleType()
+	var issues []LocaleIssue
+	for _, key := range missingKeys(locale) {
+		issues = append(issues, LocaleIssue{Locale: typ, Kind: LocaleIssueMissingKey, Key: string(key), Message: "required key is missing or empty"})
+	}
+	for _, key := range localeKeys {
+		switch key {
+		case confSetPeriodBeforeTime, parentLocale:
+		case digits:
+			if value := locale.GetString(key); value != "" && !isDigitSet(value) {
+				issues = append(issues, LocaleIssue{Locale: typ, Kind: LocaleIssueInvalidValue, Key: string(key),
+					Message: fmt.Sprintf("must be the 10 digits from 0 to 9, got %s", value)})
+			}
+		case daysOfTheWeek, monthsOfTheYear:
+			if values, size := locale.GetSlice(key), localeSliceSize(key); len(values) > 0 && len(values) != size {
+				issues = append(issues, LocaleIssue{Locale: typ, Kind: LocaleIssueLength, Key: string(key),
+					Message: fmt.Sprintf("must have %d items, got %d", size, len(values))})
+			}
+		default:
+			if value := locale.GetString(key); value != "" {
+				issues = append(issues, checkPlaceholders(typ, key, map[string]string{string(PluralOther): value})...)
+			}
+		}
+	}
+	return issues
+}
+
+// checkPlaceholders checks that the forms of the string of the key have as many %s placeholders as the English one.
+// The forms of a plural category but "other" may leave out the count, i.e. "every minute" for 1 in English.
+func checkPlaceholders(typ LocaleType, key LocaleKey, forms map[string]string) []LocaleIssue {
+	expected := expectedPlaceholders(key)
+	categories := make([]string, 0, len(forms))
+	for category := range forms {
+		categories = append(categories, category)
+	}
+	sort.Strings(categories)
+
+	var issues []LocaleIssue
+	for _, category := range categories {
+		got := strings.Count(forms[category], "%s")
+		if got == expected || forms[category] == "" || (category != string(PluralOther) && got == expected-1) {
+			continue
+		}
+		message := fmt.Sprintf("has %d %%s placeholders, English has %d", got, expected)
+		if len(forms) > 1 {
+			message = fmt.Sprintf("%s form %s", category, message)
+		}
+		issues = append(issues, LocaleIssue{Locale: typ, Kind: LocaleIssuePlaceholders, Key: string(key), Message: message})
+	}
+	return issues
+}
+
+// expectedPlaceholders returns the number of %s placeholders of the English string of the key,
+// or the number of X0 and X1 in the key if English doesn't have it (i.e. commaOnlyInMonthX0).
+func expectedPlaceholders(key LocaleKey) int {
+	englishLocaleOnce.Do(func() {
+		englishLocaleMap = make(map[string]interface{})
+		_ = json.Unmarshal([]byte(i18n.Locale_en), &englishLocaleMap)
+	})
+	if value, ok := englishLocaleMap[string(key)]; ok && value != "" {
+		return strings.Count(localeForms(value)[string(PluralOther)], "%s")
+	}
+	return strings.Count(string(key), "X0") + strings.Count(string(key), "X1")
+}
+
+// localeForms returns the forms of a locale string by plural category, "other" for a string without plural forms.
+func localeForms(value interface{}) map[string]string {
+	forms := make(map[string]string)
+	switch casted := value.(type) {
+	case string:
+		forms[string(PluralOther)] = casted
+	case map[string]interface{}:
+		for category, form := range casted {
+			forms[category], _ = form.(string)
+		}
+	}
+	return forms
+}
+
+func localeSliceSize(key LocaleKey) int {
+	if key == monthsOfTheYear {
+		return 12
+	}
+	return 7
+}
+
+func sortLocaleIssues(issues []LocaleIssue) {
+	sort.SliceStable(issues, func(i, j int) bool {
+		if issues[i].Locale != issues[j].Locale {
+			return issues[i].Locale < issues[j].Locale
+		}
+		return issues[i].Key < issues[j].Key
+	})
+}
@@ -0,0 +1,163 @@
+package cron
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/lnquy/cron/i18n"
+)
+
+func TestCheckLocales(t *testing.T) {
+	// The built-in translations must not break at runtime
+	issues, err := CheckLocales()
+	if err != nil {
+		t.Fatalf("failed to check locales: %s", err)
+	}
+	for _, issue := range issues {
+		t.Errorf("%s", issue)
+	}
+
+	if _, err := CheckLocales("xx"); err == nil {
+		t.Errorf("xx: expected unsupported locale error, got nil")
+	}
+}
+
+func TestCheckLocales_Custom(t *testing.T) {
+	en, err := lookupLocale(Locale_en)
+	if err != nil {
+		t.Fatalf("failed to look up locale: %s", err)
+	}
+	file, err := LoadLocaleFromReader(strings.NewReader(strings.Replace(i18n.Locale_en, `"at": "At"`, `"at": "At %s"`, 1)), "en_file")
+	if err != nil {
+		t.Fatalf("failed to load locale: %s", err)
+	}
+	RegisterLocale("en_pirate", &brokenLocale{Locale: en})
+	RegisterLocale("en_file", file)
+	defer func() {
+		localeRegistryMu.Lock()
+		delete(localeRegistry, "en_pirate")
+		delete(localeRegistry, "en_file")
+		localeRegistryMu.Unlock()
+	}()
+
+	issues, err := CheckLocales("en_pirate", "en_file")
+	if err != nil {
+		t.Fatalf("failed to check locales: %s", err)
+	}
+	expected := []LocaleIssue{
+		{Locale: "en_file", Kind: LocaleIssuePlaceholders, Key: "at", Message: "has 1 %s placeholders, English has 0"},
+		{Locale: "en_pirate", Kind: LocaleIssuePlaceholders, Key: "everyMinuteBetweenX0AndX1", Message: "has 1 %s placeholders, English has 2"},
+		{Locale: "en_pirate", Kind: LocaleIssueMissingKey, Key: "everySecond", Message: "required key is missing or empty"},
+		{Locale: "en_pirate", Kind: LocaleIssueLength, Key: "monthsOfTheYear", Message: "must have 12 items, got 11"},
+	}
+	if !reflect.DeepEqual(issues, expected) {
+		t.Errorf("expected '%v', got '%v'", expected, issues)
+	}
+}
+
+func TestCheckLocaleFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cron-locale")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	type testCase struct {
+		name    string
+		inFile  string
+		inData  string
+		out     []LocaleIssue
+		outErr  bool
+		outKind LocaleIssueKind // Kind of the only issue, instead of the issues
+	}
+
+	tcs := []testCase{
+		{name: "should pass valid JSON locale", inFile: "de.json", inData: i18n.Locale_de},
+		{name: "should pass valid YAML locale", inFile: "de.yaml", inData: toLocaleYAML(t, i18n.Locale_de, nil)},
+		{name: "should pass regional locale", inFile: "de_CH.yml", inData: "parentLocale: de\neveryMinute: jede Minute, gell\n"},
+		{
+			name:    "should report JSON which fails to parse",
+			inFile:  "de.json",
+			inData:  strings.Replace(i18n.Locale_de, `"everyMinute": "jede Minute",`, `"everyMinute": "jede Minute"`, 1),
+			outKind: LocaleIssueParse,
+		},
+		{
+			name:   "should report key issues",
+			inFile: "fr.yaml",
+			inData: toLocaleYAML(t, i18n.Locale_fr, map[string]interface{}{
+				"everyMinute":             nil,
+				"everyHours":              "toutes les heures",
+				"commaX0ThroughX1":        ", de %s à",
+				"confSetPeriodBeforeTime": "no",
+				"monthsOfTheYear":         []interface{}{"janvier", "février"},
+				"everyX0Minutes":          map[string]interface{}{"one": "toutes les minutes", "other": "toutes les minutes"},
+			}),
+			out: []LocaleIssue{
+				{Locale: "fr", Kind: LocaleIssuePlaceholders, Key: "commaX0ThroughX1", Message: "has 1 %s placeholders, English has 2"},
+				{Locale: "fr", Kind: LocaleIssueInvalidValue, Key: "confSetPeriodBeforeTime", Message: "must be a boolean, got no"},
+				{Locale: "fr", Kind: LocaleIssueUnknownKey, Key: "everyHours", Message: "not a key of a locale"},
+				{Locale: "fr", Kind: LocaleIssueMissingKey, Key: "everyMinute", Message: "required key is missing or empty"},
+				{Locale: "fr", Kind: LocaleIssuePlaceholders, Key: "everyX0Minutes", Message: "other form has 0 %s placeholders, English has 1"},
+				{Locale: "fr", Kind: LocaleIssueLength, Key: "monthsOfTheYear", Message: "must have 12 items, got 2"},
+			},
+		},
+		{name: "should fail on unsupported extension", inFile: "de.txt", inData: i18n.Locale_de, outErr: true},
+	}
+
+	for i, tc := range tcs {
+		path := filepath.Join(dir, tc.inFile)
+		if err := ioutil.WriteFile(path, []byte(tc.inData), 0600); err != nil {
+			t.Fatalf("failed to write locale file: %s", err)
+		}
+		issues, err := CheckLocaleFile(path)
+		if tc.outErr {
+			if err == nil {
+				t.Errorf("%d. %s: expected error, got nil", i, tc.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%d. %s: expected nil, got error '%v'", i, tc.name, err)
+			continue
+		}
+		if tc.outKind != "" {
+			if len(issues) != 1 || issues[0].Kind != tc.outKind {
+				t.Errorf("%d. %s: expected a %s issue, got '%v'", i, tc.name, tc.outKind, issues)
+			}
+			continue
+		}
+		if !reflect.DeepEqual(issues, tc.out) {
+			t.Errorf("%d. %s: expected '%v', got '%v'", i, tc.name, tc.out, issues)
+		}
+	}
+}
+
+// brokenLocale is a custom Locale implementation with a few broken strings.
+type brokenLocale struct {
+	Locale
+}
+
+func (l *brokenLocale) GetLocaleType() LocaleType {
+	return "en_pirate"
+}
+
+func (l *brokenLocale) GetString(key LocaleKey) string {
+	switch key {
+	case everySecond:
+		return ""
+	case everyMinuteBetweenX0AndX1:
+		return "every minute until %s"
+	}
+	return l.Locale.GetString(key)
+}
+
+func (l *brokenLocale) GetSlice(key LocaleKey) []string {
+	if key == monthsOfTheYear {
+		return l.Locale.GetSlice(key)[:11]
+	}
+	return l.Locale.GetSlice(key)
+}
@@ -4,5 +4,6 @@ func da_TestCases() []localeTestCase {
 	return []localeTestCase{
 		// TODO: Need help
 		{inExpr: "* * * * *", outErr: nil, outDesc: "Hvert minut"},
+		{inExpr: "@reboot", outErr: nil, outDesc: "Ved systemstart"},
 	}
 }
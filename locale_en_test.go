@@ -33,7 +33,7 @@ func en_TestCases() []localeTestCase {
 		{inExpr: "0-10 11 * * *", outErr: nil, outDesc: "Every minute between 11:00 AM and 11:10 AM"},
 		{inExpr: "23 12 * Jan-Mar *", outErr: nil, outDesc: "At 12:23 PM, January through March"},
 		{inExpr: "23 12 * JAN-FEB *", outErr: nil, outDesc: "At 12:23 PM, January through February"},
-		{inExpr: "1 1,3-4 * * *", outErr: nil, outDesc: "At 1 minutes past the hour, at 01:00 AM and 03:00 AM through 04:59 AM"},
+		{inExpr: "1 1,3-4 * * *", outErr: nil, outDesc: "At 1 minute past the hour, at 01:00 AM and 03:00 AM through 04:59 AM"},
 		{inExpr: "* 0 */4 * * *", outErr: nil, outDesc: "Every second, at 0 minutes past the hour, every 4 hours"},
 		{inExpr: "*/10 0 * * * *", outErr: nil, outDesc: "Every 10 seconds, at 0 minutes past the hour"},
 		{inExpr: "* 0 0 * * *", outErr: nil, outDesc: "Every second, at 0 minutes past the hour, between 12:00 AM and 12:59 AM"},
@@ -125,6 +125,22 @@ func en_TestCases() []localeTestCase {
 		{inExpr: "30 4 1 * *", isVerbose: true, outErr: nil, outDesc: "At 04:30 AM, on day 1 of the month"},
 		{inExpr: "0 13 * * 1", isVerbose: true, outErr: nil, outDesc: "At 01:00 PM, only on Monday"},
 
+		// Macro
+		{inExpr: "@yearly", outErr: nil, outDesc: "At 12:00 AM, on day 1 of the month, only in January"},
+		{inExpr: "@annually", outErr: nil, outDesc: "At 12:00 AM, on day 1 of the month, only in January"},
+		{inExpr: "@monthly", outErr: nil, outDesc: "At 12:00 AM, on day 1 of the month"},
+		{inExpr: "@weekly", outErr: nil, outDesc: "At 12:00 AM, only on Sunday"},
+		{inExpr: "@weekly", isDOWStartsAtOne: true, outErr: nil, outDesc: "At 12:00 AM, only on Sunday"},
+		{inExpr: "@daily", outErr: nil, outDesc: "At 12:00 AM"},
+		{inExpr: "@midnight", outErr: nil, outDesc: "At 12:00 AM"},
+		{inExpr: "@hourly", outErr: nil, outDesc: "Every hour"},
+		{inExpr: "@DAILY", outErr: nil, outDesc: "At 12:00 AM"},
+		{inExpr: "@reboot", outErr: nil, outDesc: "At system startup"},
+		{inExpr: "@every 1h30m", outErr: nil, outDesc: "Every 90 minutes"},
+		{inExpr: "@every 2h", outErr: nil, outDesc: "Every 2 hours"},
+		{inExpr: "@every 1h", outErr: nil, outDesc: "Every hour"},
+		{inExpr: "@every 45s", outErr: nil, outDesc: "Every 45 seconds"},
+
 		// Error
 		{inExpr: "sdlksldksldksd", outErr: InvalidExprError, outDesc: ""},
 		{inExpr: "", outErr: InvalidExprError, outDesc: ""},
@@ -4,5 +4,8 @@ func fa_TestCases() []localeTestCase {
 	return []localeTestCase{
 		// TODO: Need help
 		{inExpr: "* * * * *", outErr: nil, outDesc: "هر دقیقه"},
+		{inExpr: "@reboot", outErr: nil, outDesc: "هنگام راه‌اندازی سیستم"},
+		{inExpr: "30 9 * * *", outErr: nil, outDesc: "در ۰۹:۳۰ AM"},
+		{inExpr: "*/5 * * * *", outErr: nil, outDesc: "هر ۵ دقیقه"},
 	}
 }
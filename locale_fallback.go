@@ -0,0 +1,172 @@
+package cron
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DefaultParentLocale returns the parent of the locale type: the closest registered locale of its fallback chain
+// in the same script (see LocaleFallbacks), i.e. pt_BR for "pt_PT", or Locale_en for a base language.
+// Locale_en has no parent.
+func DefaultParentLocale(typ LocaleType) LocaleType {
+	tag, ok := parseLanguageTag(string(typ))
+	if !ok {
+		return Locale_en
+	}
+	if tag.language == "en" && tag.script == "" && tag.region == "" {
+		return ""
+	}
+
+	script := tag.scriptOrLikely()
+	for _, candidate := range tag.fallbacks() {
+		if strings.EqualFold(string(candidate), string(typ)) {
+			continue
+		}
+		if parsed, ok := parseLanguageTag(string(candidate)); ok {
+			if s := parsed.scriptOrLikely(); s != "" && script != "" && s != script {
+				continue // i.e. zh_TW doesn't fall back to zh_CN
+			}
+		}
+		if isRegisteredLocale(candidate) {
+			return candidate
+		}
+	}
+	return Locale_en
+}
+
+// scriptOrLikely returns the script of the tag, or the likely script of its region.
+func (tag languageTag) scriptOrLikely() string {
+	if tag.script != "" {
+		return tag.script
+	}
+	return likelyScripts[tag.language+"_"+tag.region]
+}
+
+// MissingKeys returns the required keys which are missing or empty in the registered locale, so they're resolved
+// through the parents of the locale (see the GetParentLocaleType method of Locale).
+// The optional keys (i.e. atX0MinutesPastTheHourGt20) are left out, as most of the locales don't need them.
+func MissingKeys(typ LocaleType) ([]LocaleKey, error) {
+	locale, err := lookupLocale(typ)
+	if err != nil {
+		return nil, err
+	}
+	return missingKeys(locale), nil
+}
+
+func missingKeys(locale Locale) []LocaleKey {
+	var missing []LocaleKey
+	for _, key := range localeKeys {
+		if optionalLocaleKeys[key] {
+			continue
+		}
+		switch key {
+		case daysOfTheWeek, monthsOfTheYear:
+			if len(locale.GetSlice(key)) == 0 {
+				missing = append(missing, key)
+			}
+		default:
+			if locale.GetString(key) == "" {
+				missing = append(missing, key)
+			}
+		}
+	}
+	return missing
+}
+
+// fallbackLocale resolves the missing or empty keys of a locale through its parents,
+// i.e. the regional locale, then the base language, then English.
+// The optional keys are only resolved through the parents of the same language, as they're variants
+// of the other keys for the grammar of a language.
+type fallbackLocale struct {
+	Locale
+	parents []Locale
+}
+
+// withParents returns the locale which resolves its missing keys through its parents, which are the loaded
+// locales of the expression descriptor or the registered locales.
+func (e *ExpressionDescriptor) withParents(locale Locale) (Locale, error) {
+	fallback := &fallbackLocale{Locale: locale}
+	seen := map[LocaleType]bool{locale.GetLocaleType(): true}
+	for parentType := locale.GetParentLocaleType(); parentType != ""; {
+		if seen[parentType] {
+			return nil, fmt.Errorf("locale %s has a cyclic parent %s", locale.GetLocaleType(), parentType)
+		}
+		seen[parentType] = true
+
+		parent, ok := e.locales[parentType]
+		if !ok {
+			var err error
+			if parent, err = lookupLocale(parentType); err != nil {
+				return nil, fmt.Errorf("failed to load parent of locale %s: %w", locale.GetLocaleType(), err)
+			}
+		}
+		fallback.parents = append(fallback.parents, parent)
+		parentType = parent.GetParentLocaleType()
+	}
+	if len(fallback.parents) == 0 {
+		return locale, nil
+	}
+	return fallback, nil
+}
+
+func (l *fallbackLocale) GetString(key LocaleKey) (value string) {
+	if value = l.Locale.GetString(key); value != "" {
+		return value
+	}
+	for _, parent := range l.keyParents(key) {
+		if value = parent.GetString(key); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+func (l *fallbackLocale) GetPluralString(key LocaleKey, count int) (value string) {
+	if value = l.Locale.GetPluralString(key, count); value != "" {
+		return value
+	}
+	for _, parent := range l.keyParents(key) {
+		if value = parent.GetPluralString(key, count); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+func (l *fallbackLocale) GetSlice(key LocaleKey) (values []string) {
+	if values = l.Locale.GetSlice(key); len(values) > 0 {
+		return values
+	}
+	for _, parent := range l.keyParents(key) {
+		if values = parent.GetSlice(key); len(values) > 0 {
+			return values
+		}
+	}
+	return nil
+}
+
+func (l *fallbackLocale) GetOrdinal(n int) (value string) {
+	if value = l.Locale.GetOrdinal(n); value != "" {
+		return value
+	}
+	for _, parent := range l.keyParents(ordinalX0) {
+		if value = parent.GetOrdinal(n); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// keyParents returns the parents the key is resolved through.
+func (l *fallbackLocale) keyParents(key LocaleKey) []Locale {
+	if !optionalLocaleKeys[key] {
+		return l.parents
+	}
+	lang := localeLanguage(l.GetLocaleType())
+	for i, parent := range l.parents {
+		if localeLanguage(parent.GetLocaleType()) != lang {
+			return l.parents[:i]
+		}
+	}
+	return l.parents
+}
@@ -0,0 +1,146 @@
+package cron
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/lnquy/cron/i18n"
+)
+
+func TestDefaultParentLocale(t *testing.T) {
+	tcs := []struct {
+		in  LocaleType
+		out LocaleType
+	}{
+		{in: Locale_en, out: ""},
+		{in: Locale_de, out: Locale_en},
+		{in: "de_CH", out: Locale_de},
+		{in: "en_GB", out: Locale_en},
+		{in: "pt_PT", out: Locale_pt_BR},
+		{in: "es_419", out: Locale_es},
+		{in: Locale_zh_TW, out: Locale_en},
+		{in: "zh_HK", out: Locale_zh_TW},
+		{in: "zh_SG", out: Locale_zh_CN},
+		{in: "1x", out: Locale_en},
+	}
+	for i, tc := range tcs {
+		if got := DefaultParentLocale(tc.in); got != tc.out {
+			t.Errorf("%d. %s: expected '%s', got '%s'", i, tc.in, tc.out, got)
+		}
+	}
+}
+
+func TestMissingKeys(t *testing.T) {
+	for _, typ := range RegisteredLocales() {
+		missing, err := MissingKeys(typ)
+		if err != nil || len(missing) > 0 {
+			t.Errorf("%s: expected no missing keys, got '%v' and error '%v'", typ, missing, err)
+		}
+	}
+
+	locale, err := LoadLocaleFromReader(strings.NewReader("parentLocale: de\neveryMinute: jede Minute, gell\n"), "de_CH")
+	if err != nil {
+		t.Fatalf("failed to load locale: %s", err)
+	}
+	missing := missingKeys(locale)
+	if len(missing) != len(localeKeys)-len(optionalLocaleKeys)-1 || missing[0] != everyHour {
+		t.Errorf("expected all the required keys but everyMinute, got '%v'", missing)
+	}
+
+	if _, err := MissingKeys("xx"); err == nil {
+		t.Errorf("xx: expected unsupported locale error, got nil")
+	}
+}
+
+func TestExpressionDescriptor_ToDescription_ParentLocale(t *testing.T) {
+	type testCase struct {
+		name     string
+		inLocale string
+		inType   LocaleType
+		inExpr   string
+		out      string
+	}
+
+	tcs := []testCase{
+		{
+			name:     "should use regional key",
+			inLocale: "parentLocale: de\neveryMinute: jede Minute, gell\n",
+			inType:   "de_CH",
+			inExpr:   "* * * * *",
+			out:      "Jede Minute, gell",
+		},
+		{
+			name:     "should resolve missing key through base language",
+			inLocale: "parentLocale: de\neveryMinute: jede Minute, gell\n",
+			inType:   "de_CH",
+			inExpr:   "*/5 * * * *",
+			out:      "Alle 5 Minuten",
+		},
+		{
+			name:     "should resolve missing optional key through same language",
+			inLocale: "parentLocale: ro\n",
+			inType:   "ro_MD",
+			inExpr:   "25 * * * *",
+			out:      "La și 25 de minute",
+		},
+		{
+			name:     "should resolve empty key through English",
+			inLocale: strings.Replace(i18n.Locale_de, `"everyMinute": "jede Minute"`, `"everyMinute": ""`, 1),
+			inType:   "xx",
+			inExpr:   "* * * JAN *",
+			out:      "Every minute, nur im Januar",
+		},
+	}
+
+	for i, tc := range tcs {
+		locale, err := LoadLocaleFromReader(strings.NewReader(tc.inLocale), tc.inType)
+		if err != nil {
+			t.Fatalf("%d. %s: failed to load locale: %s", i, tc.name, err)
+		}
+		exprDesc, err := NewDescriptor(SetLocaleLoaders(locale))
+		if err != nil {
+			t.Errorf("%d. %s: expected nil, got error '%v'", i, tc.name, err)
+			continue
+		}
+		desc, err := exprDesc.ToDescription(tc.inExpr, tc.inType)
+		if err != nil {
+			t.Errorf("%d. %s: expected nil, got error '%v'", i, tc.name, err)
+			continue
+		}
+		if desc != tc.out {
+			t.Errorf("%d. %s: expected '%s', got '%s'", i, tc.name, tc.out, desc)
+		}
+	}
+}
+
+func TestFallbackLocale_OptionalKeys(t *testing.T) {
+	locale, err := LoadLocaleFromReader(strings.NewReader("parentLocale: de\n"), "de_CH")
+	if err != nil {
+		t.Fatalf("failed to load locale: %s", err)
+	}
+	exprDesc, err := NewDescriptor(SetLocaleLoaders(locale))
+	if err != nil {
+		t.Fatalf("failed to create expression descriptor: %s", err)
+	}
+
+	// English has the optional key, but a German description must not be resolved in English
+	got := exprDesc.getLocale("de_CH")
+	if value := got.GetString(commaEveryHour); value != "" {
+		t.Errorf("expected '', got '%s'", value)
+	}
+	if value := got.GetString(everyHour); value != "jede Stunde" {
+		t.Errorf("expected 'jede Stunde', got '%s'", value)
+	}
+	if values := got.GetSlice(daysOfTheWeek); len(values) != 7 || !reflect.DeepEqual(values[:2], []string{"Sonntag", "Montag"}) {
+		t.Errorf("expected German days of the week, got '%v'", values)
+	}
+}
+
+func TestNewDescriptor_CyclicParentLocale(t *testing.T) {
+	a, _ := LoadLocaleFromReader(strings.NewReader("parentLocale: xx_b\n"), "xx_a")
+	b, _ := LoadLocaleFromReader(strings.NewReader("parentLocale: xx_a\n"), "xx_b")
+	if _, err := NewDescriptor(SetLocaleLoaders(a, b)); err == nil {
+		t.Errorf("expected cyclic parent error, got nil")
+	}
+}
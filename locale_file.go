@@ -0,0 +1,339 @@
+package cron
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	yamlKeyRegex = regexp.MustCompile(`^([A-Za-z0-9_]+):(?:\s+(.*))?$`)
+)
+
+// LocaleKeysError is the error of a locale file which doesn't have the keys of a locale.
+type LocaleKeysError struct {
+	Locale  LocaleType
+	Missing []string // Required keys which are not in the file
+	Unknown []string // Keys of the file which are not keys of a locale
+	Invalid []string // Keys of the file which have a value of the wrong type, i.e. a number instead of a string
+}
+
+func (e *LocaleKeysError) Error() string {
+	var problems []string
+	if len(e.Missing) > 0 {
+		problems = append(problems, "missing keys: "+strings.Join(e.Missing, ", "))
+	}
+	if len(e.Unknown) > 0 {
+		problems = append(problems, "unknown keys: "+strings.Join(e.Unknown, ", "))
+	}
+	if len(e.Invalid) > 0 {
+		problems = append(problems, "invalid values of keys: "+strings.Join(e.Invalid, ", "))
+	}
+	return fmt.Sprintf("invalid locale %s, %s", e.Locale, strings.Join(problems, "; "))
+}
+
+// LoadLocaleFromFile loads the locale from a JSON (.json) or YAML (.yaml, .yml) file, which has the same keys
+// as the locales of the i18n package. The locale type is the name of the file, i.e. "pt_BR" for "i18n/pt_BR.yaml".
+// A file with a "parentLocale" key (i.e. "parentLocale: de" for "de_CH.yaml") may leave out the keys
+// it doesn't override, they're resolved through the parent.
+//
+// To describe the CRON expressions in the loaded locale, please see the SetLocaleLoaders() option.
+func LoadLocaleFromFile(path string) (Locale, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open locale file: %w", err)
+	}
+	defer f.Close()
+	data, err := ioutil.ReadAll(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read locale file: %w", err)
+	}
+
+	ext := filepath.Ext(path)
+	typ := LocaleType(strings.TrimSuffix(filepath.Base(path), ext))
+	switch strings.ToLower(ext) {
+	case ".json":
+		return loadLocale(data, typ, json.Unmarshal)
+	case ".yaml", ".yml":
+		return loadLocale(data, typ, unmarshalYAML)
+	}
+	return nil, fmt.Errorf("unsupported locale file extension %s, must be .json, .yaml or .yml", ext)
+}
+
+// LoadLocaleFromReader loads the locale of type typ from a JSON or YAML document, which has the same keys
+// as the locales of the i18n package. The document is decoded as JSON if it starts with {, as YAML otherwise.
+//
+// Only a simple YAML mapping is supported: the values are strings (quoted if they start or end with spaces
+// or punctuation, i.e. ", every day"), booleans, lists of strings and the plural forms of strings.
+func LoadLocaleFromReader(r io.Reader, typ LocaleType) (Locale, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read locale: %w", err)
+	}
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		return loadLocale(data, typ, json.Unmarshal)
+	}
+	return loadLocale(data, typ, unmarshalYAML)
+}
+
+func loadLocale(data []byte, typ LocaleType, unmarshal func([]byte, interface{}) error) (Locale, error) {
+	if typ == "" || typ == LocaleAll {
+		return nil, fmt.Errorf("invalid locale type %q", typ)
+	}
+	raw := make(map[string]interface{})
+	if err := unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to decode locale %s: %w", typ, err)
+	}
+
+	localeMap, err := validateLocaleMap(typ, raw)
+	if err != nil {
+		return nil, err
+	}
+	return &LocaleLoader{localeType: typ, data: localeMap}, nil
+}
+
+// validateLocaleMap checks the decoded locale against the set of LocaleKeys, and returns the locale map
+// in the form of the LocaleLoader data.
+func validateLocaleMap(typ LocaleType, raw map[string]interface{}) (localeMap map[string]interface{}, err error) {
+	keysErr := &LocaleKeysError{Locale: typ}
+	localeMap = make(map[string]interface{}, len(raw))
+
+	known := make(map[string]bool, len(localeKeys))
+	for _, key := range localeKeys {
+		known[string(key)] = true
+		value, ok := raw[string(key)]
+		if !ok {
+			if !optionalLocaleKeys[key] {
+				keysErr.Missing = append(keysErr.Missing, string(key))
+			}
+			continue
+		}
+
+		switch key {
+		case confSetPeriodBeforeTime:
+			v, ok := value.(bool)
+			if !ok {
+				keysErr.Invalid = append(keysErr.Invalid, string(key))
+				continue
+			}
+			localeMap[string(key)] = v
+		case parentLocale:
+			v, ok := value.(string)
+			if !ok {
+				keysErr.Invalid = append(keysErr.Invalid, string(key))
+				continue
+			}
+			localeMap[string(key)] = v
+		case digits:
+			v, ok := value.(string)
+			if !ok || (v != "" && !isDigitSet(v)) {
+				keysErr.Invalid = append(keysErr.Invalid, string(key))
+				continue
+			}
+			localeMap[string(key)] = v
+		case daysOfTheWeek, monthsOfTheYear:
+			size := 7
+			if key == monthsOfTheYear {
+				size = 12
+			}
+			v, ok := toStrings(value)
+			if !ok || len(v) != size {
+				keysErr.Invalid = append(keysErr.Invalid, string(key))
+				continue
+			}
+			localeMap[string(key)] = v
+		default:
+			if !isLocaleString(value) {
+				keysErr.Invalid = append(keysErr.Invalid, string(key))
+				continue
+			}
+			localeMap[string(key)] = value
+		}
+	}
+	for key := range raw {
+		if !known[key] {
+			keysErr.Unknown = append(keysErr.Unknown, key)
+		}
+	}
+	sort.Strings(keysErr.Unknown)
+	if parent, _ := localeMap[string(parentLocale)].(string); parent != "" {
+		keysErr.Missing = nil // The missing keys are resolved through the parent
+	}
+
+	if len(keysErr.Missing) > 0 || len(keysErr.Unknown) > 0 || len(keysErr.Invalid) > 0 {
+		return nil, keysErr
+	}
+	return localeMap, nil
+}
+
+// isLocaleString reports whether the value is a string, or the plural forms of a string: an object of
+// the CLDR plural categories which has at least the "other" form, i.e. {"one": "every %s minute", "other": "every %s minutes"}.
+func isLocaleString(value interface{}) bool {
+	switch casted := value.(type) {
+	case string:
+		return true
+	case map[string]interface{}:
+		if _, ok := casted[string(PluralOther)]; !ok {
+			return false
+		}
+		for category, form := range casted {
+			if _, ok := form.(string); !ok || !pluralCategories[PluralCategory(category)] {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func toStrings(value interface{}) ([]string, bool) {
+	items, ok := value.([]interface{})
+	if !ok {
+		v, ok := value.([]string)
+		return v, ok
+	}
+	values := make([]string, 0, len(items))
+	for _, item := range items {
+		s, ok := item.(string)
+		if !ok {
+			return nil, false
+		}
+		values = append(values, s)
+	}
+	return values, true
+}
+
+// unmarshalYAML decodes the YAML mapping of a locale into v, which must be a *map[string]interface{}.
+// The values of the keys are scalars, or blocks of list items (i.e. "  - Sunday") or of the plural forms of
+// a string (i.e. "  one: every %s minute").
+func unmarshalYAML(data []byte, v interface{}) error {
+	out, ok := v.(*map[string]interface{})
+	if !ok {
+		return fmt.Errorf("cannot decode YAML into %T", v)
+	}
+	m := *out
+
+	blockKey := "" // Key of the block being decoded, i.e. "daysOfTheWeek:" followed by "  - Sunday" lines
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimRight(scanner.Text(), " \t\r")
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") || trimmed == "---" {
+			continue
+		}
+
+		if strings.HasPrefix(trimmed, "- ") || trimmed == "-" {
+			list, ok := m[blockKey].([]interface{})
+			if blockKey == "" || !ok {
+				return fmt.Errorf("line %d: list item without a key", lineNo)
+			}
+			item, err := parseYAMLScalar(strings.TrimSpace(strings.TrimPrefix(trimmed, "-")))
+			if err != nil {
+				return fmt.Errorf("line %d: %w", lineNo, err)
+			}
+			s, ok := item.(string)
+			if !ok {
+				s = fmt.Sprint(item)
+			}
+			m[blockKey] = append(list, s)
+			continue
+		}
+
+		matches := yamlKeyRegex.FindStringSubmatch(trimmed)
+		if matches == nil {
+			return fmt.Errorf("line %d: must be key: value", lineNo)
+		}
+		if line != trimmed {
+			// Plural form of the string of the block key
+			forms, ok := m[blockKey].(map[string]interface{})
+			if blockKey == "" || matches[2] == "" {
+				return fmt.Errorf("line %d: only the plural forms of a string can be nested", lineNo)
+			}
+			if !ok {
+				if list, isList := m[blockKey].([]interface{}); isList && len(list) > 0 {
+					return fmt.Errorf("line %d: list items and plural forms can't be mixed", lineNo)
+				}
+				forms = make(map[string]interface{})
+				m[blockKey] = forms
+			}
+			value, err := parseYAMLScalar(matches[2])
+			if err != nil {
+				return fmt.Errorf("line %d: %w", lineNo, err)
+			}
+			forms[matches[1]] = value
+			continue
+		}
+		if _, ok := m[matches[1]]; ok {
+			return fmt.Errorf("line %d: duplicated key %s", lineNo, matches[1])
+		}
+
+		blockKey = ""
+		if matches[2] == "" {
+			blockKey = matches[1]
+			m[blockKey] = []interface{}{}
+			continue
+		}
+		value, err := parseYAMLScalar(matches[2])
+		if err != nil {
+			return fmt.Errorf("line %d: %w", lineNo, err)
+		}
+		m[matches[1]] = value
+	}
+	return scanner.Err()
+}
+
+// parseYAMLScalar parses the YAML value of a key or list item: a quoted or plain string, a boolean
+// or a flow list of strings (i.e. [Sun, Mon]).
+func parseYAMLScalar(s string) (interface{}, error) {
+	switch {
+	case strings.HasPrefix(s, `"`):
+		// Double quoted YAML strings escape like JSON strings
+		var v string
+		if err := json.NewDecoder(strings.NewReader(s)).Decode(&v); err != nil {
+			return nil, fmt.Errorf("invalid double quoted string %s", s)
+		}
+		return v, nil
+	case strings.HasPrefix(s, "'"):
+		end := strings.LastIndex(s, "'")
+		if end == 0 {
+			return nil, fmt.Errorf("invalid single quoted string %s", s)
+		}
+		return strings.Replace(s[1:end], "''", "'", -1), nil
+	case strings.HasPrefix(s, "["):
+		end := strings.LastIndex(s, "]")
+		if end == -1 {
+			return nil, fmt.Errorf("flow list must be closed by ]: %s", s)
+		}
+		items := []interface{}{}
+		if inner := strings.TrimSpace(s[1:end]); inner != "" {
+			for _, item := range strings.Split(inner, ",") {
+				v, err := parseYAMLScalar(strings.TrimSpace(item))
+				if err != nil {
+					return nil, err
+				}
+				items = append(items, v)
+			}
+		}
+		return items, nil
+	}
+
+	// Plain scalar, a comment may follow it
+	if idx := strings.Index(s, " #"); idx > -1 {
+		s = strings.TrimSpace(s[:idx])
+	}
+	switch s {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	}
+	return s, nil
+}
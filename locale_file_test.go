@@ -0,0 +1,276 @@
+package cron
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/lnquy/cron/i18n"
+)
+
+func TestLoadLocaleFromReader(t *testing.T) {
+	type testCase struct {
+		name       string
+		inData     string
+		outDesc    string
+		outMissing []string
+		outUnknown []string
+		outInvalid []string
+	}
+
+	tcs := []testCase{
+		{name: "should load JSON locale", inData: i18n.Locale_en, outDesc: "At 12:00 PM, Monday through Friday"},
+		{name: "should load YAML locale", inData: toLocaleYAML(t, i18n.Locale_en, nil), outDesc: "At 12:00 PM, Monday through Friday"},
+		{
+			name:    "should load fixed translation",
+			inData:  toLocaleYAML(t, i18n.Locale_en, map[string]interface{}{"commaX0ThroughX1": ", from %s to %s"}),
+			outDesc: "At 12:00 PM, from Monday to Friday",
+		},
+		{
+			name:       "should list missing and unknown keys",
+			inData:     toLocaleYAML(t, i18n.Locale_en, map[string]interface{}{"everyMinute": nil, "atSpace": nil, "everyMinutes": "every minute"}),
+			outMissing: []string{"everyMinute", "atSpace"},
+			outUnknown: []string{"everyMinutes"},
+		},
+		{
+			name:       "should list invalid values",
+			inData:     toLocaleYAML(t, i18n.Locale_en, map[string]interface{}{"confSetPeriodBeforeTime": "yes", "daysOfTheWeek": []interface{}{"Sunday"}}),
+			outInvalid: []string{"confSetPeriodBeforeTime", "daysOfTheWeek"},
+		},
+		{
+			name:       "should list invalid JSON values",
+			inData:     strings.Replace(i18n.Locale_en, `"at": "At"`, `"at": 1`, 1),
+			outInvalid: []string{"at"},
+		},
+	}
+
+	for i, tc := range tcs {
+		locale, err := LoadLocaleFromReader(strings.NewReader(tc.inData), "en_test")
+		if tc.outMissing != nil || tc.outUnknown != nil || tc.outInvalid != nil {
+			var keysErr *LocaleKeysError
+			if !errors.As(err, &keysErr) {
+				t.Errorf("%d. %s: expected *LocaleKeysError, got '%v'", i, tc.name, err)
+				continue
+			}
+			if !reflect.DeepEqual(keysErr.Missing, tc.outMissing) || !reflect.DeepEqual(keysErr.Unknown, tc.outUnknown) ||
+				!reflect.DeepEqual(keysErr.Invalid, tc.outInvalid) {
+				t.Errorf("%d. %s: expected missing %v, unknown %v and invalid %v keys, got '%v'",
+					i, tc.name, tc.outMissing, tc.outUnknown, tc.outInvalid, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%d. %s: expected nil, got error '%v'", i, tc.name, err)
+			continue
+		}
+
+		exprDesc, err := NewDescriptor(SetLocaleLoaders(locale))
+		if err != nil {
+			t.Fatalf("failed to create expression descriptor: %s", err)
+		}
+		desc, err := exprDesc.ToDescription("0 12 * * MON-FRI", "en_test")
+		if err != nil {
+			t.Errorf("%d. %s: expected nil, got error '%v'", i, tc.name, err)
+			continue
+		}
+		if desc != tc.outDesc {
+			t.Errorf("%d. %s: expected '%s', got '%s'", i, tc.name, tc.outDesc, desc)
+		}
+	}
+}
+
+func TestLoadLocaleFromFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cron-locale")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	fixed := map[string]interface{}{"everyMinute": "jede einzelne Minute"}
+	files := map[string]string{
+		"de.json":   strings.Replace(i18n.Locale_de, `"everyMinute": "jede Minute"`, `"everyMinute": "jede einzelne Minute"`, 1),
+		"de.yaml":   toLocaleYAML(t, i18n.Locale_de, fixed),
+		"de.yml":    toLocaleYAML(t, i18n.Locale_de, fixed),
+		"de.txt":    i18n.Locale_de,
+		"vi_VN.yml": "everyMinute: every minute\n",
+	}
+	for name, data := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(data), 0600); err != nil {
+			t.Fatalf("failed to write locale file: %s", err)
+		}
+	}
+
+	for _, name := range []string{"de.json", "de.yaml", "de.yml"} {
+		locale, err := LoadLocaleFromFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Errorf("%s: expected nil, got error '%v'", name, err)
+			continue
+		}
+		if locale.GetLocaleType() != Locale_de {
+			t.Errorf("%s: expected locale '%s', got '%s'", name, Locale_de, locale.GetLocaleType())
+		}
+
+		// The loaded locale replaces the built-in one
+		exprDesc, err := NewDescriptor(SetLocales(Locale_de), SetLocaleLoaders(locale))
+		if err != nil {
+			t.Fatalf("failed to create expression descriptor: %s", err)
+		}
+		if desc, _ := exprDesc.ToDescription("* * * * *", Locale_de); desc != "Jede einzelne Minute" {
+			t.Errorf("%s: expected 'Jede einzelne Minute', got '%s'", name, desc)
+		}
+	}
+
+	if _, err := LoadLocaleFromFile(filepath.Join(dir, "de.txt")); err == nil {
+		t.Errorf("de.txt: expected unsupported extension error, got nil")
+	}
+	if _, err := LoadLocaleFromFile(filepath.Join(dir, "it.json")); err == nil {
+		t.Errorf("it.json: expected file not found error, got nil")
+	}
+	var keysErr *LocaleKeysError
+	if _, err := LoadLocaleFromFile(filepath.Join(dir, "vi_VN.yml")); !errors.As(err, &keysErr) || keysErr.Locale != "vi_VN" {
+		t.Errorf("vi_VN.yml: expected *LocaleKeysError of vi_VN, got '%v'", err)
+	}
+}
+
+func TestUnmarshalYAML(t *testing.T) {
+	type testCase struct {
+		name   string
+		inData string
+		out    map[string]interface{}
+		outErr bool
+	}
+
+	tcs := []testCase{
+		{
+			name: "should decode scalars",
+			inData: `# Comment
+---
+plain: every minute
+double: ", every %s days"
+single: 'it''s %s'
+empty: ""
+unicode: "à %s"
+yes: true
+no: false # Comment
+`,
+			out: map[string]interface{}{
+				"plain": "every minute", "double": ", every %s days", "single": "it's %s", "empty": "",
+				"unicode": "à %s", "yes": true, "no": false,
+			},
+		},
+		{
+			name: "should decode lists",
+			inData: `block:
+  - Sunday
+  - "Monday"
+flow: [Jan, 'Feb', "Mar"]
+emptyFlow: []
+`,
+			out: map[string]interface{}{
+				"block":     []interface{}{"Sunday", "Monday"},
+				"flow":      []interface{}{"Jan", "Feb", "Mar"},
+				"emptyFlow": []interface{}{},
+			},
+		},
+		{
+			name: "should decode plural forms",
+			inData: `everyX0Minutes:
+  one: "every %s minute"
+  other: every %s minutes
+`,
+			out: map[string]interface{}{
+				"everyX0Minutes": map[string]interface{}{"one": "every %s minute", "other": "every %s minutes"},
+			},
+		},
+		{name: "should fail on deeply nested mapping", inData: "parent:\n  child:\n    value: value\n", outErr: true},
+		{name: "should fail on mixed list and mapping", inData: "parent:\n  - item\n  child: value\n", outErr: true},
+		{name: "should fail on list item without key", inData: "- Sunday\n", outErr: true},
+		{name: "should fail on duplicated key", inData: "at: At\nat: At\n", outErr: true},
+		{name: "should fail on invalid line", inData: "at At\n", outErr: true},
+		{name: "should fail on unclosed quote", inData: "at: \"At\n", outErr: true},
+		{name: "should fail on unclosed flow list", inData: "days: [Sun, Mon\n", outErr: true},
+	}
+
+	for i, tc := range tcs {
+		got := make(map[string]interface{})
+		err := unmarshalYAML([]byte(tc.inData), &got)
+		if tc.outErr {
+			if err == nil {
+				t.Errorf("%d. %s: expected error, got nil", i, tc.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%d. %s: expected nil, got error '%v'", i, tc.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tc.out) {
+			t.Errorf("%d. %s: expected '%v', got '%v'", i, tc.name, tc.out, got)
+		}
+	}
+}
+
+func TestBuiltInLocales_Keys(t *testing.T) {
+	for _, typ := range RegisteredLocales() {
+		loaders, err := NewLocaleLoaders(typ)
+		if err != nil {
+			t.Fatalf("failed to load locale %s: %s", typ, err)
+		}
+		_, err = validateLocaleMap(typ, loaders[0].(*LocaleLoader).data)
+		var keysErr *LocaleKeysError
+		if errors.As(err, &keysErr) && (len(keysErr.Missing) > 0 || len(keysErr.Invalid) > 0) {
+			t.Errorf("%s: expected all the keys, got '%v'", typ, err)
+		}
+	}
+}
+
+// toLocaleYAML converts the JSON locale to YAML, with the overridden keys replaced (or removed if nil).
+func toLocaleYAML(t *testing.T, data string, overrides map[string]interface{}) string {
+	m := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(data), &m); err != nil {
+		t.Fatalf("failed to decode JSON locale: %s", err)
+	}
+	for k, v := range overrides {
+		if v == nil {
+			delete(m, k)
+			continue
+		}
+		m[k] = v
+	}
+
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var sb strings.Builder
+	for _, k := range keys {
+		switch v := m[k].(type) {
+		case []interface{}:
+			sb.WriteString(k + ":\n")
+			for _, item := range v {
+				sb.WriteString(fmt.Sprintf("  - %q\n", item))
+			}
+		case map[string]interface{}:
+			sb.WriteString(k + ":\n")
+			for category, form := range v {
+				quoted, _ := json.Marshal(form)
+				sb.WriteString(fmt.Sprintf("  %s: %s\n", category, quoted))
+			}
+		case string:
+			quoted, _ := json.Marshal(v)
+			sb.WriteString(fmt.Sprintf("%s: %s\n", k, quoted))
+		default:
+			sb.WriteString(fmt.Sprintf("%s: %v\n", k, v))
+		}
+	}
+	return sb.String()
+}
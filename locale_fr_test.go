@@ -5,5 +5,6 @@ func fr_TestCases() []localeTestCase {
 		// TODO: Need help
 		{inExpr: "* * * * *", outErr: nil, outDesc: "Toutes les minutes"},
 		{inExpr: "*/5 15 * * MON-FRI", outErr: nil, outDesc: "Toutes les 5 minutes, de 03:00 PM à 03:59 PM, de lundi à vendredi"},
+		{inExpr: "@reboot", outErr: nil, outDesc: "Au démarrage du système"},
 	}
 }
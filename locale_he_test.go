@@ -5,5 +5,6 @@ func he_TestCases() []localeTestCase {
 		// TODO: Need help
 		{inExpr: "* * * * *", outErr: nil, outDesc: "כל דקה"},
 		{inExpr: "*/5 15 * * MON-FRI", outErr: nil, outDesc: "כל 5 דקות, 15:00 עד 15:59, יום שני עד יום שישי", is24HourTimeFormat: true},
+		{inExpr: "@reboot", outErr: nil, outDesc: "בעת הפעלת המערכת"},
 	}
 }
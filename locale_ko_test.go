@@ -4,5 +4,6 @@ func ko_TestCases() []localeTestCase {
 	return []localeTestCase{
 		// TODO: Need help
 		{inExpr: "* * * * *", outErr: nil, outDesc: "1분마다"},
+		{inExpr: "@reboot", outErr: nil, outDesc: "시스템 시작 시"},
 	}
 }
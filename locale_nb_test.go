@@ -9,5 +9,6 @@ func nb_TestCases() []localeTestCase {
 		{inExpr: "15 11 * 1/1 MON#1", outErr: nil, outDesc: "Kl.11:15 AM, på første mandag i måneden"},
 		{inExpr: "15 11 * 1/5 MON#1", outErr: nil, outDesc: "Kl.11:15 AM, på første mandag i måneden, hver 5 måned"},
 		{inExpr: "0 7 * * MON,TUE,THU,FRI,SUN", outErr: nil, outDesc: "Kl.07:00 AM, på mandag, tirsdag, torsdag, fredag, og søndag"},
+		{inExpr: "@reboot", outErr: nil, outDesc: "Ved systemoppstart"},
 	}
 }
@@ -0,0 +1,205 @@
+package cron
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	// likelyScripts are the scripts of the languages written in several scripts, by region.
+	likelyScripts = map[string]string{
+		"zh_CN": "Hans",
+		"zh_SG": "Hans",
+		"zh_HK": "Hant",
+		"zh_MO": "Hant",
+		"zh_TW": "Hant",
+	}
+)
+
+// languageTag is the language, script and region of a BCP 47 language tag, i.e. "zh", "Hant" and "HK" for "zh-Hant-HK".
+type languageTag struct {
+	language string
+	script   string
+	region   string
+}
+
+// parseLanguageTag parses the BCP 47 language tag, or the POSIX locale name (i.e. "pt_BR.UTF-8").
+// The variants and extensions of the tag are ignored.
+func parseLanguageTag(s string) (tag languageTag, ok bool) {
+	s = strings.TrimSpace(s)
+	if idx := strings.IndexAny(s, ".@"); idx > -1 {
+		s = s[:idx]
+	}
+	subtags := strings.FieldsFunc(s, func(r rune) bool { return r == '-' || r == '_' })
+	if len(subtags) == 0 || !isAlpha(subtags[0]) || len(subtags[0]) < 2 || len(subtags[0]) > 8 {
+		return tag, false
+	}
+
+	tag.language = strings.ToLower(subtags[0])
+	for _, subtag := range subtags[1:] {
+		switch {
+		case len(subtag) == 1:
+			return tag, true // Extensions and private use subtags
+		case len(subtag) == 4 && isAlpha(subtag) && tag.script == "" && tag.region == "":
+			tag.script = strings.ToUpper(subtag[:1]) + strings.ToLower(subtag[1:])
+		case (len(subtag) == 2 && isAlpha(subtag)) || (len(subtag) == 3 && isDigits(subtag)):
+			if tag.region == "" {
+				tag.region = strings.ToUpper(subtag)
+			}
+		}
+	}
+	return tag, true
+}
+
+// fallbacks returns the locale types of the tag, from the most to the least specific one:
+// the tag is truncated like the lookup of RFC 4647, and the locale types of the aliases follow their alias.
+func (tag languageTag) fallbacks() []LocaleType {
+	script := tag.script
+	if script == "" && tag.region != "" {
+		script = likelyScripts[tag.language+"_"+tag.region]
+	}
+
+	var chain []LocaleType
+	seen := make(map[string]bool)
+	add := func(name string) {
+		for name != "" && !seen[strings.ToLower(name)] {
+			seen[strings.ToLower(name)] = true
+			chain = append(chain, LocaleType(name))
+			name = string(localeAliases[strings.ToLower(name)])
+		}
+	}
+
+	if tag.region != "" {
+		if tag.script != "" {
+			add(tag.language + "_" + tag.script + "_" + tag.region)
+		}
+		add(tag.language + "_" + tag.region)
+	}
+	if script != "" {
+		add(tag.language + "_" + script)
+	}
+	add(tag.language)
+	return chain
+}
+
+// LocaleFallbacks returns the fallback chain of the BCP 47 language tag (i.e. "zh-Hant-HK") or locale type:
+// the locale types to try one after the other, from the most specific one to Locale_en.
+// i.e. zh_Hant_HK, zh_HK, zh_Hant, zh_TW, zh, zh_CN, en for "zh-Hant-HK".
+func LocaleFallbacks(tag string) []LocaleType {
+	var chain []LocaleType
+	if parsed, ok := parseLanguageTag(tag); ok {
+		chain = parsed.fallbacks()
+	}
+	for _, typ := range chain {
+		if typ == Locale_en {
+			return chain
+		}
+	}
+	return append(chain, Locale_en)
+}
+
+// ParseAcceptLanguage returns the language tags of the Accept-Language HTTP header, from the most to the least
+// preferred one, i.e. ["de-CH", "en"] for "de-CH;q=0.8, en;q=0.5".
+// The tags with a quality of 0, an invalid quality and the wildcard "*" are left out.
+func ParseAcceptLanguage(header string) []string {
+	type weightedTag struct {
+		tag     string
+		quality float64
+	}
+
+	var tags []weightedTag
+	for _, part := range strings.Split(header, ",") {
+		params := strings.Split(part, ";")
+		tag := strings.TrimSpace(params[0])
+		if tag == "" || tag == "*" {
+			continue
+		}
+
+		quality := 1.0
+		for _, param := range params[1:] {
+			param = strings.TrimSpace(param)
+			if !strings.HasPrefix(param, "q=") && !strings.HasPrefix(param, "Q=") {
+				continue
+			}
+			q, err := strconv.ParseFloat(param[2:], 64)
+			if err != nil || q < 0 || q > 1 {
+				q = 0
+			}
+			quality = q
+		}
+		if quality > 0 {
+			tags = append(tags, weightedTag{tag: tag, quality: quality})
+		}
+	}
+	sort.SliceStable(tags, func(i, j int) bool { return tags[i].quality > tags[j].quality })
+
+	result := make([]string, 0, len(tags))
+	for _, t := range tags {
+		result = append(result, t.tag)
+	}
+	return result
+}
+
+// NegotiateLocale returns the best of the available locales for the Accept-Language HTTP header
+// or the BCP 47 language tag, i.e. "de-CH;q=0.8, en;q=0.5" or "pt-PT".
+// The fallback chain of each tag is tried in the order of preference (see LocaleFallbacks), and Locale_en
+// is returned if none of the tags has an available locale.
+// If no locale is given, the registered locales are available.
+func NegotiateLocale(acceptLanguage string, available ...LocaleType) LocaleType {
+	if len(available) == 0 {
+		available = RegisteredLocales()
+	}
+	index := make(map[string]LocaleType, len(available))
+	for _, typ := range available {
+		index[strings.ToLower(string(typ))] = typ
+	}
+	if typ, ok := negotiateLocale(ParseAcceptLanguage(acceptLanguage), index); ok {
+		return typ
+	}
+	return Locale_en
+}
+
+// NegotiateLocale returns the best of the locales of the expression descriptor for the Accept-Language HTTP header
+// or the BCP 47 language tag, or Locale_en. See the NegotiateLocale function.
+func (e *ExpressionDescriptor) NegotiateLocale(acceptLanguage string) LocaleType {
+	available := make([]LocaleType, 0, len(e.locales))
+	for typ := range e.locales {
+		available = append(available, typ)
+	}
+	return NegotiateLocale(acceptLanguage, available...)
+}
+
+// negotiateLocale returns the first locale of the index (by lower cased locale type) in the fallback chains of the tags.
+func negotiateLocale(tags []string, index map[string]LocaleType) (LocaleType, bool) {
+	for _, tag := range tags {
+		parsed, ok := parseLanguageTag(tag)
+		if !ok {
+			continue
+		}
+		for _, candidate := range parsed.fallbacks() {
+			if typ, ok := index[strings.ToLower(string(candidate))]; ok {
+				return typ, true
+			}
+		}
+	}
+	return "", false
+}
+
+func isAlpha(s string) bool {
+	for _, r := range s {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,104 @@
+package cron
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLocaleFallbacks(t *testing.T) {
+	tcs := []struct {
+		in  string
+		out []LocaleType
+	}{
+		{in: "en", out: []LocaleType{Locale_en}},
+		{in: "de-CH", out: []LocaleType{"de_CH", Locale_de, Locale_en}},
+		{in: "pt-PT", out: []LocaleType{"pt_PT", "pt", Locale_pt_BR, Locale_en}},
+		{in: "zh-Hant-HK", out: []LocaleType{"zh_Hant_HK", "zh_HK", "zh_Hant", Locale_zh_TW, "zh", Locale_zh_CN, Locale_en}},
+		{in: "zh-HK", out: []LocaleType{"zh_HK", "zh_Hant", Locale_zh_TW, "zh", Locale_zh_CN, Locale_en}},
+		{in: "zh_CN", out: []LocaleType{Locale_zh_CN, "zh_Hans", "zh", Locale_en}},
+		{in: "es-419", out: []LocaleType{"es_419", Locale_es, Locale_en}},
+		{in: "sr-Latn-RS-u-nu-latn", out: []LocaleType{"sr_Latn_RS", "sr_RS", "sr_Latn", "sr", Locale_en}},
+		{in: "fr_FR.UTF-8", out: []LocaleType{"fr_FR", Locale_fr, Locale_en}},
+		{in: "no", out: []LocaleType{"no", Locale_nb, Locale_en}},
+		{in: "", out: []LocaleType{Locale_en}},
+		{in: "1-x", out: []LocaleType{Locale_en}},
+	}
+	for i, tc := range tcs {
+		if got := LocaleFallbacks(tc.in); !reflect.DeepEqual(got, tc.out) {
+			t.Errorf("%d. %s: expected '%v', got '%v'", i, tc.in, tc.out, got)
+		}
+	}
+}
+
+func TestParseAcceptLanguage(t *testing.T) {
+	tcs := []struct {
+		in  string
+		out []string
+	}{
+		{in: "de-CH;q=0.8, en;q=0.5", out: []string{"de-CH", "en"}},
+		{in: "en;q=0.5, fr, de;q=0.7", out: []string{"fr", "de", "en"}},
+		{in: "fr-CH, fr;q=0.9, en;q=0.8, de;q=0.7, *;q=0.5", out: []string{"fr-CH", "fr", "en", "de"}},
+		{in: "ja;q=0, ko;q=abc, ru", out: []string{"ru"}},
+		{in: "", out: []string{}},
+	}
+	for i, tc := range tcs {
+		if got := ParseAcceptLanguage(tc.in); !reflect.DeepEqual(got, tc.out) {
+			t.Errorf("%d. %s: expected '%v', got '%v'", i, tc.in, tc.out, got)
+		}
+	}
+}
+
+func TestNegotiateLocale(t *testing.T) {
+	tcs := []struct {
+		in          string
+		inAvailable []LocaleType
+		out         LocaleType
+	}{
+		{in: "de-CH;q=0.8, en;q=0.5", out: Locale_de},
+		{in: "zh-Hant-HK", out: Locale_zh_TW},
+		{in: "zh-Hans-HK", out: Locale_zh_CN},
+		{in: "pt-PT", out: Locale_pt_BR},
+		{in: "nn-NO, nb;q=0.9", out: Locale_nb},
+		{in: "xx-YY, fr;q=0.1", out: Locale_fr},
+		{in: "xx-YY", out: Locale_en},
+		{in: "zh-Hant-HK", inAvailable: []LocaleType{Locale_en, Locale_zh_CN}, out: Locale_zh_CN},
+		{in: "de-CH;q=0.8, fr;q=0.9", inAvailable: []LocaleType{Locale_en, Locale_de, Locale_fr}, out: Locale_fr},
+		{in: "de-CH", inAvailable: []LocaleType{Locale_en, "de_CH"}, out: "de_CH"},
+	}
+	for i, tc := range tcs {
+		if got := NegotiateLocale(tc.in, tc.inAvailable...); got != tc.out {
+			t.Errorf("%d. %s: expected '%s', got '%s'", i, tc.in, tc.out, got)
+		}
+	}
+}
+
+func TestExpressionDescriptor_ToDescription_LanguageTag(t *testing.T) {
+	exprDesc, err := NewDescriptor(SetLocales(Locale_en, Locale_de, Locale_zh_TW))
+	if err != nil {
+		t.Fatalf("failed to create expression descriptor: %s", err)
+	}
+
+	tcs := []struct {
+		in  LocaleType
+		out string
+	}{
+		{in: "de-AT", out: "Jede Minute"},
+		{in: "zh-Hant-HK", out: "每分鐘"},
+		{in: "zh-Hans", out: "Every minute"},
+		{in: "fr", out: "Every minute"},
+	}
+	for i, tc := range tcs {
+		desc, err := exprDesc.ToDescription("* * * * *", tc.in)
+		if err != nil {
+			t.Errorf("%d. %s: expected nil, got error '%v'", i, tc.in, err)
+			continue
+		}
+		if desc != tc.out {
+			t.Errorf("%d. %s: expected '%s', got '%s'", i, tc.in, tc.out, desc)
+		}
+	}
+
+	if got := exprDesc.NegotiateLocale("fr-FR, zh-TW;q=0.9"); got != Locale_zh_TW {
+		t.Errorf("expected '%s', got '%s'", Locale_zh_TW, got)
+	}
+}
@@ -0,0 +1,117 @@
+package cron
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/lnquy/cron/i18n"
+)
+
+var (
+	localeRegistryMu sync.RWMutex
+	localeRegistry   = make(map[LocaleType]*registeredLocale)
+
+	// localeAliases are the names ParseLocale accepts for the locales of a language with several variants,
+	// and the locales of the scripts and macrolanguages of the BCP 47 language tags.
+	localeAliases = map[string]LocaleType{
+		"no":      Locale_nb,
+		"pt":      Locale_pt_BR,
+		"zh":      Locale_zh_CN,
+		"zh_hans": Locale_zh_CN,
+		"zh_hant": Locale_zh_TW,
+	}
+)
+
+// registeredLocale is a locale of the registry. The built-in locales are decoded the first time they're used.
+type registeredLocale struct {
+	once   sync.Once
+	locale Locale
+	data   string // JSON document of a built-in locale
+	load   func() (Locale, error)
+	err    error
+}
+
+func init() {
+	for typ, data := range i18n.Locales() {
+		typ, data := LocaleType(typ), data
+		localeRegistry[typ] = &registeredLocale{data: data, load: func() (Locale, error) {
+			return decodeLocale(typ, data)
+		}}
+	}
+}
+
+// RegisterLocale registers the locale as typ, so it can be loaded with SetLocales, LocaleAll and ParseLocale
+// like the built-in locales of the i18n package, which register themselves.
+// The GetLocaleType method of the locale must return typ.
+// Registering a locale type again replaces the registered locale, i.e. to fix a built-in translation.
+// RegisterLocale panics if typ is empty or LocaleAll, or if locale is nil.
+func RegisterLocale(typ LocaleType, locale Locale) {
+	if typ == "" || typ == LocaleAll {
+		panic(fmt.Sprintf("cron: RegisterLocale with invalid locale type %q", typ))
+	}
+	if locale == nil {
+		panic("cron: RegisterLocale locale is nil")
+	}
+
+	localeRegistryMu.Lock()
+	defer localeRegistryMu.Unlock()
+	localeRegistry[typ] = &registeredLocale{locale: locale}
+}
+
+// RegisteredLocales returns the types of the registered locales, sorted.
+func RegisteredLocales() []LocaleType {
+	localeRegistryMu.RLock()
+	defer localeRegistryMu.RUnlock()
+
+	types := make([]LocaleType, 0, len(localeRegistry))
+	for typ := range localeRegistry {
+		types = append(types, typ)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+	return types
+}
+
+// lookupLocale returns the registered locale of type typ.
+func lookupLocale(typ LocaleType) (Locale, error) {
+	localeRegistryMu.RLock()
+	registered, ok := localeRegistry[typ]
+	localeRegistryMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unsupported locale: %s", typ)
+	}
+
+	registered.once.Do(func() {
+		if registered.locale == nil {
+			registered.locale, registered.err = registered.load()
+		}
+	})
+	return registered.locale, registered.err
+}
+
+// isRegisteredLocale reports whether a locale is registered as typ.
+func isRegisteredLocale(typ LocaleType) bool {
+	localeRegistryMu.RLock()
+	defer localeRegistryMu.RUnlock()
+	_, ok := localeRegistry[typ]
+	return ok
+}
+
+// findLocale returns the type of the registered locale named s (case insensitive), or of its alias.
+func findLocale(s string) (LocaleType, bool) {
+	localeRegistryMu.RLock()
+	defer localeRegistryMu.RUnlock()
+
+	if typ, ok := localeAliases[strings.ToLower(s)]; ok {
+		if _, ok := localeRegistry[typ]; ok {
+			return typ, true
+		}
+	}
+	for typ := range localeRegistry {
+		if strings.EqualFold(string(typ), s) {
+			return typ, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,125 @@
+package cron
+
+import (
+	"reflect"
+	"testing"
+)
+
+// pirateLocale is a custom Locale implementation, which overrides a few strings of the English locale.
+type pirateLocale struct {
+	Locale
+}
+
+func (l *pirateLocale) GetLocaleType() LocaleType {
+	return "en_pirate"
+}
+
+func (l *pirateLocale) GetString(key LocaleKey) string {
+	if key == everyMinute {
+		return "every minute, arr"
+	}
+	return l.Locale.GetString(key)
+}
+
+func TestRegisterLocale(t *testing.T) {
+	en, err := lookupLocale(Locale_en)
+	if err != nil {
+		t.Fatalf("failed to look up locale: %s", err)
+	}
+	RegisterLocale("en_pirate", &pirateLocale{Locale: en})
+	defer func() {
+		localeRegistryMu.Lock()
+		delete(localeRegistry, "en_pirate")
+		localeRegistryMu.Unlock()
+	}()
+
+	typ, err := ParseLocale("EN_PIRATE")
+	if err != nil || typ != "en_pirate" {
+		t.Fatalf("expected 'en_pirate', got '%s' and error '%v'", typ, err)
+	}
+
+	for _, loc := range []LocaleType{"en_pirate", LocaleAll} {
+		exprDesc, err := NewDescriptor(SetLocales(loc))
+		if err != nil {
+			t.Fatalf("failed to create expression descriptor: %s", err)
+		}
+		desc, err := exprDesc.ToDescription("* * * * *", "en_pirate")
+		if err != nil {
+			t.Errorf("%s: expected nil, got error '%v'", loc, err)
+			continue
+		}
+		if desc != "Every minute, arr" {
+			t.Errorf("%s: expected 'Every minute, arr', got '%s'", loc, desc)
+		}
+	}
+}
+
+func TestRegisterLocale_Panics(t *testing.T) {
+	en, err := lookupLocale(Locale_en)
+	if err != nil {
+		t.Fatalf("failed to look up locale: %s", err)
+	}
+
+	tcs := []struct {
+		name     string
+		inType   LocaleType
+		inLocale Locale
+	}{
+		{name: "should panic on empty type", inType: "", inLocale: en},
+		{name: "should panic on LocaleAll", inType: LocaleAll, inLocale: en},
+		{name: "should panic on nil locale", inType: "en_nil", inLocale: nil},
+	}
+	for i, tc := range tcs {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%d. %s: expected panic, got nil", i, tc.name)
+				}
+			}()
+			RegisterLocale(tc.inType, tc.inLocale)
+		}()
+	}
+}
+
+func TestParseLocale(t *testing.T) {
+	tcs := []struct {
+		in     string
+		out    LocaleType
+		outErr bool
+	}{
+		{in: "en", out: Locale_en},
+		{in: "FR", out: Locale_fr},
+		{in: "pt_br", out: Locale_pt_BR},
+		{in: "pt", out: Locale_pt_BR},
+		{in: "zh", out: Locale_zh_CN},
+		{in: "zh_tw", out: Locale_zh_TW},
+		{in: "zh-Hant-HK", out: Locale_zh_TW},
+		{in: "de-CH", out: Locale_de},
+		{in: "pt-PT", out: Locale_pt_BR},
+		{in: "xx", outErr: true},
+		{in: "all", outErr: true},
+	}
+	for i, tc := range tcs {
+		got, err := ParseLocale(tc.in)
+		if tc.outErr {
+			if err == nil {
+				t.Errorf("%d. %s: expected error, got '%s'", i, tc.in, got)
+			}
+			continue
+		}
+		if err != nil || got != tc.out {
+			t.Errorf("%d. %s: expected '%s', got '%s' and error '%v'", i, tc.in, tc.out, got, err)
+		}
+	}
+}
+
+func TestRegisteredLocales(t *testing.T) {
+	expected := []LocaleType{
+		Locale_cs, Locale_da, Locale_de, Locale_en, Locale_es, Locale_fa, Locale_fi, Locale_fr, Locale_he,
+		Locale_it, Locale_ja, Locale_ko, Locale_nb, Locale_nl, Locale_pl, Locale_pt_BR, Locale_ro, Locale_ru,
+		Locale_sk, Locale_sl, Locale_sv, Locale_sw, Locale_tr, Locale_uk, Locale_zh_CN, Locale_zh_TW,
+	}
+	if got := RegisteredLocales(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected '%v', got '%v'", expected, got)
+	}
+}
@@ -4,5 +4,6 @@ func sl_TestCases() []localeTestCase {
 	return []localeTestCase{
 		// TODO: Need help
 		{inExpr: "* * * * *", outErr: nil, outDesc: "Vsako minuto"},
+		{inExpr: "@reboot", outErr: nil, outDesc: "Ob zagonu sistema"},
 	}
 }
@@ -8,5 +8,6 @@ func sv_TestCases() []localeTestCase {
 		{inExpr: "0 12 * * *", outErr: nil, outDesc: "Kl 12:00 PM"},
 		{inExpr: "0 15 10 ? * 6#3", outErr: nil, outDesc: "Kl 10:15 AM, den tredje lördagen av månaden"},
 		{inExpr: "0 0 15 ? * MON *", outErr: nil, outDesc: "Kl 03:00 PM, varje måndag"},
+		{inExpr: "@reboot", outErr: nil, outDesc: "Vid systemstart"},
 	}
 }
@@ -0,0 +1,28 @@
+package cron
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// localizeDigits replaces the ASCII digits of the description with the digits of the locale
+// (the "digits" string of the digits from 0 to 9, i.e. "۰۱۲۳۴۵۶۷۸۹" in Persian).
+// The description is left as is if the locale has no digits.
+func localizeDigits(desc string, locale Locale) string {
+	set := locale.GetString(digits)
+	if set == "" || !isDigitSet(set) {
+		return desc
+	}
+	localDigits := []rune(set)
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return localDigits[r-'0']
+		}
+		return r
+	}, desc)
+}
+
+// isDigitSet reports whether the string is a set of the 10 digits from 0 to 9.
+func isDigitSet(s string) bool {
+	return utf8.RuneCountInString(s) == 10
+}
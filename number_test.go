@@ -0,0 +1,95 @@
+package cron
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLocalizeDigits(t *testing.T) {
+	fa, err := lookupLocale(Locale_fa)
+	if err != nil {
+		t.Fatalf("failed to look up locale: %s", err)
+	}
+	en, err := lookupLocale(Locale_en)
+	if err != nil {
+		t.Fatalf("failed to look up locale: %s", err)
+	}
+
+	tcs := []struct {
+		inDesc   string
+		inLocale Locale
+		out      string
+	}{
+		{inDesc: "در 09:30 AM", inLocale: fa, out: "در ۰۹:۳۰ AM"},
+		{inDesc: "فقط در 2024", inLocale: fa, out: "فقط در ۲۰۲۴"},
+		{inDesc: "At 09:30 AM", inLocale: en, out: "At 09:30 AM"},
+	}
+	for i, tc := range tcs {
+		if got := localizeDigits(tc.inDesc, tc.inLocale); got != tc.out {
+			t.Errorf("%d. %s: expected '%s', got '%s'", i, tc.inDesc, tc.out, got)
+		}
+	}
+}
+
+func TestExpressionDescriptor_ToDescription_NumberFormat(t *testing.T) {
+	type testCase struct {
+		name     string
+		inLocale string
+		inType   LocaleType
+		inExpr   string
+		out      string
+	}
+
+	tcs := []testCase{
+		{
+			name:   "should write times in native digits",
+			inType: Locale_fa,
+			inExpr: "30 9 * * *",
+			out:    "در ۰۹:۳۰ AM",
+		},
+		{
+			name:   "should write steps, days and years in native digits",
+			inType: Locale_fa,
+			inExpr: "*/5 * 1-15 * * 2024",
+			out:    "هر ۵ دقیقه, بین روز ۱ و ۱۵ ماه, فقط در ۲۰۲۴",
+		},
+		{
+			name:     "should use digits of custom locale",
+			inLocale: "parentLocale: en\ndigits: \"٠١٢٣٤٥٦٧٨٩\"\n",
+			inType:   "ar",
+			inExpr:   "0 12 * * *",
+			out:      "At ١٢:٠٠ PM",
+		},
+	}
+
+	for i, tc := range tcs {
+		options := []Option{SetLocales(Locale_fa)}
+		if tc.inLocale != "" {
+			locale, err := LoadLocaleFromReader(strings.NewReader(tc.inLocale), tc.inType)
+			if err != nil {
+				t.Fatalf("%d. %s: failed to load locale: %s", i, tc.name, err)
+			}
+			options = append(options, SetLocaleLoaders(locale))
+		}
+		exprDesc, err := NewDescriptor(options...)
+		if err != nil {
+			t.Fatalf("failed to create expression descriptor: %s", err)
+		}
+		desc, err := exprDesc.ToDescription(tc.inExpr, tc.inType)
+		if err != nil {
+			t.Errorf("%d. %s: expected nil, got error '%v'", i, tc.name, err)
+			continue
+		}
+		if desc != tc.out {
+			t.Errorf("%d. %s: expected '%s', got '%s'", i, tc.name, tc.out, desc)
+		}
+	}
+}
+
+func TestLoadLocaleFromReader_Digits(t *testing.T) {
+	_, err := LoadLocaleFromReader(strings.NewReader("parentLocale: en\ndigits: \"0123\"\n"), "xx")
+	keysErr, ok := err.(*LocaleKeysError)
+	if !ok || len(keysErr.Invalid) != 1 || keysErr.Invalid[0] != "digits" {
+		t.Errorf("expected invalid digits error, got '%v'", err)
+	}
+}
@@ -1,5 +1,7 @@
 package cron
 
+import "time"
+
 // SetLogger allows the expression descriptor to output log via logger.
 func SetLogger(logger Logger) Option {
 	return func(exprDesc *ExpressionDescriptor) {
@@ -33,6 +35,125 @@ func Use24HourTimeFormat(v bool) Option {
 	}
 }
 
+// TimeFormat configures the expression descriptor to output the times of day in the layout, which overrides
+// Use24HourTimeFormat. The layout is either a Go time layout (i.e. "15:04" or "3:04 PM", see the time package),
+// or a pattern of:
+//  - H, HH: hour (0-23), HH with a leading zero
+//  - h, hh: hour (1-12), hh with a leading zero
+//  - m, mm, s, ss: minute and second, mm and ss with a leading zero
+//  - a: AM/PM of the locale
+//  - 'text': literal text
+//  - [...]: optional part, left out if its minutes and seconds are zero
+// A layout starting with "N|" uses the noon and midnight of the locale for 12:00 and 00:00.
+//
+// Example: "N|h[:mm] a" outputs "9 AM", "9:05 AM" and "noon", "H 'Uhr'" outputs "9 Uhr".
+func TimeFormat(layout string) Option {
+	return func(exprDesc *ExpressionDescriptor) {
+		exprDesc.timeFormat = layout
+	}
+}
+
+// UseOrdinals configures the expression descriptor to output the days of the month in ordinal numbers
+// (i.e. "on the 15th of the month" instead of "on day 15 of the month", "on the 2nd to last day of the month"
+// for L-1) in the locales which have ordinal numbers.
+func UseOrdinals(v bool) Option {
+	return func(exprDesc *ExpressionDescriptor) {
+		exprDesc.isUseOrdinals = v
+	}
+}
+
+// UseBidiIsolates configures the expression descriptor to wrap the left-to-right fragments of the descriptions
+// in the right-to-left locales (i.e. "10:00 AM" in Hebrew or Persian, see IsRightToLeft) in Unicode directional
+// isolates (U+2066 and U+2069), so they're displayed in order in terminals and HTML.
+func UseBidiIsolates(v bool) Option {
+	return func(exprDesc *ExpressionDescriptor) {
+		exprDesc.isBidiIsolates = v
+	}
+}
+
+// StrictValidation configures the parser to reject the CRON expressions which are in bounds
+// but can never fire or make no sense, such as "0 0 30 2 *" (February 30th), backward ranges (5-2),
+// zero steps (*/0), "#6" and "L-40".
+func StrictValidation(v bool) Option {
+	return func(exprDesc *ExpressionDescriptor) {
+		exprDesc.isStrictValidation = v
+	}
+}
+
+// HashSeed enables the Jenkins hashed values (H, H(a-b) and H/n) in the CRON expressions.
+// The hashed values are resolved to concrete values derived from the seed (i.e. the job name),
+// so the same seed always resolves to the same schedule, while different seeds spread out the load.
+func HashSeed(seed string) Option {
+	return func(exprDesc *ExpressionDescriptor) {
+		exprDesc.isHashEnabled = true
+		exprDesc.hashSeed = seed
+	}
+}
+
+// SymbolicHash configures the expression descriptor to describe the hashed values symbolically
+// (i.e. "at a job-specific minute") instead of with the values they resolve to (default).
+func SymbolicHash(v bool) Option {
+	return func(exprDesc *ExpressionDescriptor) {
+		exprDesc.isSymbolicHash = v
+	}
+}
+
+// SetDialect configures the flavor of CRON expressions the parser accepts (DialectDefault by default).
+// The dialect decides the layout of the fields, the supported macros and the special characters,
+// i.e. DialectEventBridge accepts "cron(0 12 * * ? *)" and "rate(5 minutes)".
+func SetDialect(dialect Dialect) Option {
+	return func(exprDesc *ExpressionDescriptor) {
+		exprDesc.dialect = dialect
+	}
+}
+
+// SetFieldLayout pins the layout of the fields of the CRON expressions, so it's not decided by the dialect
+// or guessed from the number of parts (LayoutAuto by default).
+// The expressions which don't have the number of parts of the layout are rejected, i.e. "0 0 12 * * */2" is
+// every 2 years at 12:00 with LayoutYearLast, but an error with LayoutFiveFields.
+// Predefined macros (i.e. @daily) are accepted with any layout.
+func SetFieldLayout(layout FieldLayout) Option {
+	return func(exprDesc *ExpressionDescriptor) {
+		exprDesc.fieldLayout = layout
+	}
+}
+
+// WithLocation configures the time zone of the CRON expressions which have no CRON_TZ= or TZ= prefix
+// (i.e. "CRON_TZ=Asia/Tokyo 0 9 * * *"). When the time zone is known, the description mentions it,
+// i.e. "At 09:00 AM JST", and the schedule returned by ToSchedule fires in it.
+func WithLocation(loc *time.Location) Option {
+	return func(exprDesc *ExpressionDescriptor) {
+		exprDesc.location = loc
+	}
+}
+
+// WithViewerLocation configures the expression descriptor to also describe the times of the CRON expressions
+// which have a known time zone converted into the time zone of the viewer, i.e. "At 09:00 AM JST (01:00 AM Europe/London)".
+// Only the specific times (i.e. 09:00) and the lists of hours with a single minute (i.e. "30 6,14 * * *") are converted;
+// the ranges and the intervals (i.e. "0 9-17 * * *" or "*/15 * * * *") stay in the expression's time zone.
+// The times are converted at the current date, so they follow the daylight saving time of both zones.
+// The days of the description are the days of the expression's time zone, so the converted times which fall
+// on another day for the viewer are marked with the day shift, i.e. "At 11:00 PM UTC (08:00 AM +1 day Asia/Tokyo)".
+func WithViewerLocation(loc *time.Location) Option {
+	return func(exprDesc *ExpressionDescriptor) {
+		exprDesc.viewerLocation = loc
+	}
+}
+
+// SetLocaleLoaders adds the locales which are not built in the i18n package, i.e. loaded by LoadLocaleFromFile,
+// to the locales that the expression descriptor will output in.
+// A loaded locale replaces the built-in locale of the same type.
+func SetLocaleLoaders(locales ...Locale) Option {
+	return func(exprDesc *ExpressionDescriptor) {
+		if exprDesc.locales == nil {
+			exprDesc.locales = make(map[LocaleType]Locale)
+		}
+		for _, locale := range locales {
+			exprDesc.locales[locale.GetLocaleType()] = locale
+		}
+	}
+}
+
 // SetLocales initializes the list of initial locales that the expression descriptor will output in.
 // By default, the expression descriptor always initialize English (Locale_en).
 func SetLocales(locales ...LocaleType) Option {
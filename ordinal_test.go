@@ -0,0 +1,123 @@
+package cron
+
+import (
+	"testing"
+)
+
+func TestOrdinalCategoryOf(t *testing.T) {
+	type testCase struct {
+		inLocale LocaleType
+		inCounts []int
+		out      []PluralCategory
+	}
+
+	tcs := []testCase{
+		{
+			inLocale: Locale_en,
+			inCounts: []int{1, 2, 3, 4, 11, 12, 13, 21, 22, 23, 101, 111},
+			out: []PluralCategory{PluralOne, PluralTwo, PluralFew, PluralOther, PluralOther, PluralOther, PluralOther,
+				PluralOne, PluralTwo, PluralFew, PluralOne, PluralOther},
+		},
+		{inLocale: Locale_fr, inCounts: []int{1, 2, 21}, out: []PluralCategory{PluralOne, PluralOther, PluralOther}},
+		{inLocale: Locale_sv, inCounts: []int{1, 2, 3, 11, 12, 21, 22}, out: []PluralCategory{PluralOne, PluralOne, PluralOther, PluralOther, PluralOther, PluralOne, PluralOne}},
+		{inLocale: Locale_de, inCounts: []int{1, 2}, out: []PluralCategory{PluralOther, PluralOther}},
+	}
+
+	for i, tc := range tcs {
+		for j, count := range tc.inCounts {
+			if got := OrdinalCategoryOf(tc.inLocale, count); got != tc.out[j] {
+				t.Errorf("%d. %s: expected '%s' for %d, got '%s'", i, tc.inLocale, tc.out[j], count, got)
+			}
+		}
+	}
+}
+
+func TestLocaleLoader_GetOrdinal(t *testing.T) {
+	type testCase struct {
+		inLocale LocaleType
+		inN      int
+		out      string
+	}
+
+	tcs := []testCase{
+		{inLocale: Locale_en, inN: 1, out: "1st"},
+		{inLocale: Locale_en, inN: 12, out: "12th"},
+		{inLocale: Locale_en, inN: 23, out: "23rd"},
+		{inLocale: Locale_de, inN: 15, out: "15."},
+		{inLocale: Locale_fr, inN: 1, out: "1er"},
+		{inLocale: Locale_fr, inN: 15, out: "15"},
+		{inLocale: Locale_sv, inN: 22, out: "22:a"},
+		{inLocale: Locale_nl, inN: 3, out: "3e"},
+		{inLocale: Locale_es, inN: 3, out: ""},
+	}
+
+	for i, tc := range tcs {
+		locale, err := lookupLocale(tc.inLocale)
+		if err != nil {
+			t.Fatalf("failed to look up locale: %s", err)
+		}
+		if got := locale.GetOrdinal(tc.inN); got != tc.out {
+			t.Errorf("%d. %s: expected '%s' for %d, got '%s'", i, tc.inLocale, tc.out, tc.inN, got)
+		}
+	}
+}
+
+func TestExpressionDescriptor_ToDescription_Ordinals(t *testing.T) {
+	type testCase struct {
+		inLocale   LocaleType
+		inOrdinals bool
+		inExpr     string
+		out        string
+	}
+
+	tcs := []testCase{
+		{inLocale: Locale_en, inOrdinals: true, inExpr: "0 0 15 * *", out: "At 12:00 AM, on the 15th of the month"},
+		{inLocale: Locale_en, inOrdinals: true, inExpr: "0 0 1,2,3,22 * *", out: "At 12:00 AM, on the 1st, 2nd, 3rd, and 22nd of the month"},
+		{inLocale: Locale_en, inOrdinals: true, inExpr: "0 0 L-1 * *", out: "At 12:00 AM, on the 2nd to last day of the month"},
+		{inLocale: Locale_en, inOrdinals: true, inExpr: "0 0 1-15 * *", out: "At 12:00 AM, between day 1 and 15 of the month"},
+		{inLocale: Locale_en, inOrdinals: true, inExpr: "0 0 * * SUN#2", out: "At 12:00 AM, on the second Sunday of the month"},
+		{inLocale: Locale_en, inOrdinals: false, inExpr: "0 0 15 * *", out: "At 12:00 AM, on day 15 of the month"},
+		{inLocale: Locale_en, inOrdinals: false, inExpr: "0 0 L-1 * *", out: "At 12:00 AM, 1 day before the last day of the month"},
+		{inLocale: Locale_de, inOrdinals: true, inExpr: "0 0 15 * *", out: "Um 12:00 AM, am 15. des Monats"},
+		{inLocale: Locale_de, inOrdinals: true, inExpr: "0 0 L-2 * *", out: "Um 12:00 AM, am 3. letzten Tag des Monats"},
+		{inLocale: Locale_fr, inOrdinals: true, inExpr: "0 0 1,15 * *", out: "À 12:00 AM, le 1er et 15 du mois"},
+		{inLocale: Locale_sv, inOrdinals: true, inExpr: "0 0 15 * *", out: "Kl 12:00 AM, på den 15:e av månaden"},
+		{inLocale: Locale_es, inOrdinals: true, inExpr: "0 0 15 * *", out: "A las 12:00 AM, el día 15 del mes"},
+	}
+
+	for i, tc := range tcs {
+		exprDesc, err := NewDescriptor(SetLocales(tc.inLocale), UseOrdinals(tc.inOrdinals))
+		if err != nil {
+			t.Fatalf("failed to create expression descriptor: %s", err)
+		}
+		desc, err := exprDesc.ToDescription(tc.inExpr, tc.inLocale)
+		if err != nil {
+			t.Errorf("%d. %s: expected nil, got error '%v'", i, tc.inExpr, err)
+			continue
+		}
+		if desc != tc.out {
+			t.Errorf("%d. %s: expected '%s', got '%s'", i, tc.inExpr, tc.out, desc)
+		}
+	}
+}
+
+func TestGetNthDescription(t *testing.T) {
+	en, err := lookupLocale(Locale_en)
+	if err != nil {
+		t.Fatalf("failed to look up locale: %s", err)
+	}
+	es, err := lookupLocale(Locale_es)
+	if err != nil {
+		t.Fatalf("failed to look up locale: %s", err)
+	}
+
+	if got := getNthDescription("3", en); got != "third" {
+		t.Errorf("expected 'third', got '%s'", got)
+	}
+	if got := getNthDescription("6", en); got != "6th" {
+		t.Errorf("expected '6th', got '%s'", got)
+	}
+	if got := getNthDescription("6", es); got != "6" {
+		t.Errorf("expected '6', got '%s'", got)
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
+	"unicode"
 )
 
 var (
@@ -18,6 +20,7 @@ var (
 	InvalidExprMonthError      = errors.New("invalid expression, month part")
 	InvalidExprDayOfWeekError  = errors.New("invalid expression, day of week part")
 	InvalidExprYearError       = errors.New("invalid expression, year part")
+	InvalidExprNeverFiresError = errors.New("invalid expression, schedule never fires")
 )
 
 var (
@@ -26,11 +29,24 @@ var (
 	everySecMinRegex = regexp.MustCompile(`[*/]`)
 	everyHourRegex   = regexp.MustCompile(`[*\-,/]`)
 
-	rangeRegex = regexp.MustCompile(`^[*\-,]`)
+	rangeRegex = regexp.MustCompile(`^\*|-|,`)
 
 	invalidCharsDOWDOMRegex = regexp.MustCompile(`[a-km-vx-zA-KM-VX-Z]`)
 )
 
+var (
+	// macros are the predefined CRON expressions (Vixie cron and robfig/cron).
+	macros = map[string]string{
+		"@yearly":   "0 0 1 1 *",
+		"@annually": "0 0 1 1 *",
+		"@monthly":  "0 0 1 * *",
+		"@weekly":   "0 0 * * sun",
+		"@daily":    "0 0 * * *",
+		"@midnight": "0 0 * * *",
+		"@hourly":   "0 * * * *",
+	}
+)
+
 var (
 	zeroRune  int32 = 48
 	sevenRune int32 = 55
@@ -65,42 +81,164 @@ var (
 
 type (
 	cronParser struct {
-		isDOWStartsAtOne bool
+		isDOWStartsAtOne   bool
+		isStrictValidation bool
+		isHashEnabled      bool
+		hashSeed           string
+		dialect            Dialect
+		fieldLayout        FieldLayout
+		location           *time.Location
 	}
 
 	// Parser represents the cron parser.
 	Parser interface {
 		Parse(expr string) (exprParts []string, err error)
 	}
+
+	// ParseError is the error of an invalid token in the CRON expression.
+	// ParseError wraps one of the InvalidExpr*Error errors, so it can be checked with errors.Is.
+	ParseError struct {
+		Field  FieldType // Field of the invalid token
+		Offset int       // Byte offset of the invalid token in the original CRON expression
+		Token  string    // The invalid token, as written in the original CRON expression
+		Min    int       // Lowest value allowed in the field
+		Max    int       // Highest value allowed in the field
+		Err    error
+
+		msg string
+	}
+
+	// specialExpr is a CRON expression which has no time fields, so it can't be normalized to
+	// the 7-part-CRON format.
+	specialExpr struct {
+		isReboot bool          // @reboot
+		every    time.Duration // @every <duration>
+	}
+
+	// exprTokens holds the original parts of the CRON expression, so errors can point at them.
+	exprTokens struct {
+		parts    []string       // Original parts in 7-part-CRON format, empty if not provided
+		offsets  []int          // Byte offsets of the original parts, -1 if not provided
+		dialect  Dialect        // Dialect the expression is written in
+		location *time.Location // Time zone of the CRON_TZ= or TZ= prefix, or of the WithLocation option, nil if unknown
+	}
 )
 
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("%s: %q at offset %d, allowed values are %d-%d: %s", e.msg, e.Token, e.Offset, e.Min, e.Max, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
 // Parse parses, normalizes and validates the CRON expression.
 // If the CRON expression is valid, then the returned list always the normalized 7-part-CRON format.
 // Example: "* 5 * * *" => ["", "*", "5", "*", "*", "*", ""]
+//
+// If a part of the CRON expression is invalid, the returned error wraps a *ParseError.
 func (p *cronParser) Parse(expr string) (exprParts []string, err error) {
-	exprParts, err = p.extractExprParts(expr)
+	exprParts, _, err = p.parse(expr)
+	return exprParts, err
+}
+
+// parse parses the CRON expression like Parse, and also returns the original tokens of the expression.
+func (p *cronParser) parse(expr string) (exprParts []string, tokens *exprTokens, err error) {
+	tokens, err = p.extractExprParts(expr)
 	if err != nil {
-		return nil, fmt.Errorf("failed to extract expression parts: %w", err)
+		return nil, nil, fmt.Errorf("failed to extract expression parts: %w", err)
+	}
+	p = p.dialectParser(tokens.dialect)
+
+	exprParts = make([]string, len(tokens.parts))
+	for i, part := range tokens.parts {
+		exprParts[i] = strings.ToLower(part)
+	}
+
+	if err = p.resolveHashes(exprParts, tokens); err != nil {
+		return nil, nil, fmt.Errorf("failed to resolve hashed expression parts: %w", err)
 	}
 
-	if err = p.normalize(exprParts); err != nil {
-		return nil, fmt.Errorf("failed to normalize expression parts: %w", err)
+	if err = p.normalize(exprParts, tokens); err != nil {
+		return nil, nil, fmt.Errorf("failed to normalize expression parts: %w", err)
 	}
 
-	if err = p.validate(exprParts); err != nil {
-		return nil, fmt.Errorf("invalid CRON expression: %w", err)
+	if err = p.validate(exprParts, tokens); err != nil {
+		return nil, nil, fmt.Errorf("invalid CRON expression: %w", err)
 	}
-	return exprParts, nil
+	return exprParts, tokens, nil
 }
 
-func (p *cronParser) extractExprParts(expr string) (exprParts []string, err error) {
+func (p *cronParser) extractExprParts(expr string) (tokens *exprTokens, err error) {
 	if strings.TrimSpace(expr) == "" {
 		return nil, InvalidExprError
 	}
 
-	expr = strings.ToLower(expr)
-	exprParts = make([]string, 7, 7)
-	parts := strings.Fields(expr)
+	expr, location, err := p.unwrapLocation(expr)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(expr) == "" {
+		return nil, fmt.Errorf("time zone prefix must be followed by the expression: %w", InvalidExprError)
+	}
+	if location == nil {
+		location = p.location
+	}
+
+	special, err := p.parseSpecial(expr)
+	if err != nil {
+		return nil, err
+	}
+	if special != nil {
+		return nil, fmt.Errorf("%s has no time fields: %w", strings.TrimSpace(expr), InvalidExprError)
+	}
+
+	expr, dialect, err := p.unwrapExpr(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	parts, offsets := splitFields(expr)
+	if len(parts) == 0 { // i.e. cron()
+		return nil, fmt.Errorf("expression has no parts: %w", InvalidExprError)
+	}
+	layouts, source := dialect.layouts(), fmt.Sprintf("the %s dialect", dialect)
+	if p.fieldLayout != LayoutAuto {
+		layouts, source = []FieldLayout{p.fieldLayout}, fmt.Sprintf("the %s layout", p.fieldLayout)
+	}
+	if strings.HasPrefix(parts[0], "@") {
+		// Expand the macro to the equivalent 5-part CRON expression
+		macro, ok := macros[strings.ToLower(parts[0])]
+		if !ok {
+			return nil, fmt.Errorf("unknown macro %s: %w", parts[0], InvalidExprError)
+		}
+		if !dialect.supportsMacro(strings.ToLower(parts[0])) {
+			return nil, fmt.Errorf("macro %s is not supported by the %s dialect: %w", parts[0], dialect, InvalidExprError)
+		}
+		if len(parts) > 1 {
+			return nil, fmt.Errorf("macro %s must not be followed by other parts: %w", parts[0], InvalidExprError)
+		}
+		parts = strings.Fields(macro)
+		offsets = []int{offsets[0], offsets[0], offsets[0], offsets[0], offsets[0]}
+		layouts = []FieldLayout{LayoutFiveFields} // Whatever the layout is
+	}
+
+	tokens = &exprTokens{
+		parts:    make([]string, 7),
+		offsets:  []int{-1, -1, -1, -1, -1, -1, -1},
+		dialect:  dialect,
+		location: location,
+	}
+
+	if layouts != nil {
+		if err = placeParts(parts, offsets, layouts, source, tokens); err != nil {
+			return nil, err
+		}
+		if err = p.dialectParser(dialect).validateDialect(tokens); err != nil {
+			return nil, err
+		}
+		return tokens, nil
+	}
 
 	switch {
 	case len(parts) < 5:
@@ -108,26 +246,116 @@ func (p *cronParser) extractExprParts(expr string) (exprParts []string, err erro
 	case len(parts) == 5:
 		// Expression has 5 parts (standard POSIX CRON)
 		// => Prepend 1 and append 1 empty part at the beginning and the end of exprParts
-		copy(exprParts[1:], append(parts, ""))
+		copy(tokens.parts[1:], parts)
+		copy(tokens.offsets[1:], offsets)
 	case len(parts) == 6:
 		// Has year (last part) or second (first part)
 		if yearRegex.MatchString(parts[5]) {
 			// Year provided => Prepend 1 empty part at the beginning for second
-			copy(exprParts[1:], parts)
+			copy(tokens.parts[1:], parts)
+			copy(tokens.offsets[1:], offsets)
 			break
 		}
 		// Second provided => Last parts (year) is empty
-		copy(exprParts, parts)
+		copy(tokens.parts, parts)
+		copy(tokens.offsets, offsets)
 	case len(parts) > 7:
 		return nil, fmt.Errorf("expression has %d parts, at most 7 parts allowed: %w", len(parts), InvalidExprError)
 	default: // Expression has 7 parts
-		exprParts = parts
+		tokens.parts = parts
+		tokens.offsets = offsets
 	}
 
-	return exprParts, nil
+	return tokens, nil
 }
 
-func (p *cronParser) normalize(exprParts []string) (err error) {
+// parseSpecial parses the CRON expressions which have no time fields (@reboot, @every <duration> and rate()).
+// If expr is not one of them, nil is returned.
+func (p *cronParser) parseSpecial(expr string) (special *specialExpr, err error) {
+	if expr, _, err = p.unwrapLocation(expr); err != nil {
+		return nil, err
+	}
+	parts := strings.Fields(strings.ToLower(expr))
+	if len(parts) == 0 {
+		return nil, nil
+	}
+
+	if strings.HasPrefix(parts[0], "rate(") {
+		return p.parseRate(expr)
+	}
+	if (parts[0] == "@reboot" || parts[0] == "@every") && !p.dialect.supportsMacro(parts[0]) {
+		return nil, fmt.Errorf("macro %s is not supported by the %s dialect: %w", parts[0], p.dialect, InvalidExprError)
+	}
+
+	switch parts[0] {
+	case "@reboot":
+		if len(parts) > 1 {
+			return nil, fmt.Errorf("@reboot must not be followed by other parts: %w", InvalidExprError)
+		}
+		return &specialExpr{isReboot: true}, nil
+	case "@every":
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("@every must be followed by a duration: %w", InvalidExprError)
+		}
+		every, err := time.ParseDuration(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid @every duration %s: %w", parts[1], InvalidExprError)
+		}
+		if every < time.Second || every%time.Second != 0 {
+			return nil, fmt.Errorf("@every duration must be a positive number of seconds, got %s: %w", parts[1], InvalidExprError)
+		}
+		return &specialExpr{every: every}, nil
+	}
+	return nil, nil
+}
+
+// splitFields splits the expression around white spaces like strings.Fields, and also returns
+// the byte offset of each field in the expression.
+func splitFields(expr string) (fields []string, offsets []int) {
+	start := -1
+	for i, r := range expr {
+		if unicode.IsSpace(r) {
+			if start > -1 {
+				fields = append(fields, expr[start:i])
+				offsets = append(offsets, start)
+				start = -1
+			}
+			continue
+		}
+		if start == -1 {
+			start = i
+		}
+	}
+	if start > -1 {
+		fields = append(fields, expr[start:])
+		offsets = append(offsets, start)
+	}
+	return fields, offsets
+}
+
+// errorAt returns the *ParseError of the item at itemIdx (comma separated) of a field.
+// If itemIdx is negative, the error points at the whole field.
+func (t *exprTokens) errorAt(field FieldType, itemIdx int, bounds [2]int, msg string, err error) *ParseError {
+	token, offset := t.parts[field], t.offsets[field]
+	if items := strings.Split(token, ","); itemIdx > -1 && itemIdx < len(items) {
+		for _, item := range items[:itemIdx] {
+			offset += len(item) + 1
+		}
+		token = items[itemIdx]
+	}
+
+	return &ParseError{
+		Field:  field,
+		Offset: offset,
+		Token:  token,
+		Min:    bounds[0],
+		Max:    bounds[1],
+		Err:    err,
+		msg:    msg,
+	}
+}
+
+func (p *cronParser) normalize(exprParts []string, tokens *exprTokens) (err error) {
 	second := exprParts[0]
 	minute := exprParts[1]
 	hour := exprParts[2]
@@ -142,37 +370,27 @@ func (p *cronParser) normalize(exprParts []string) (err error) {
 	// Convert ? to * for hour. ? isn't valid for hour position but we can work around it
 	hour = strings.Replace(hour, "?", "*", 1)
 
-	// Convert 0/, 1/ to */
-	if strings.Index(second, "0/") == 0 {
-		second = strings.Replace(second, "0/", "*/", 1)
-	}
-	if strings.Index(minute, "0/") == 0 {
-		minute = strings.Replace(minute, "0/", "*/", 1)
-	}
-	if strings.Index(hour, "0/") == 0 {
-		hour = strings.Replace(hour, "0/", "*/", 1)
-	}
-	if strings.Index(dayOfMonth, "1/") == 0 {
-		dayOfMonth = strings.Replace(dayOfMonth, "1/", "*/", 1)
-	}
-	if strings.Index(month, "1/") == 0 {
-		month = strings.Replace(month, "1/", "*/", 1)
-	}
-	if strings.Index(dayOfWeek, "1/") == 0 {
-		dayOfWeek = strings.Replace(dayOfWeek, "1/", "*/", 1)
-	}
-	if strings.Index(year, "1/") == 0 {
-		year = strings.Replace(year, "1/", "*/", 1)
-	}
+	// Convert 0/, 1/ to */ (i.e. 0/5 and 00/5 => */5)
+	second = toAnyStep(second, 0)
+	minute = toAnyStep(minute, 0)
+	hour = toAnyStep(hour, 0)
+	dayOfMonth = toAnyStep(dayOfMonth, 1)
+	month = toAnyStep(month, 1)
+	dayOfWeek = toAnyStep(dayOfWeek, 1)
+	year = toAnyStep(year, 1)
 
 	// Adjust DOW based on isDOWStartsAtZero option
 	// Normalized DOW: 0=Sunday/6=Saturday
 	dowRunes := []rune(dayOfWeek)
+	isStepOrNth := false
 	for i, c := range dowRunes {
-		if c == '/' || c == '#' { // Keep days after # and / as it is
-			break
+		switch c {
+		case '/', '#': // Keep the step and the nth after / and # of the item as it is
+			isStepOrNth = true
+		case ',':
+			isStepOrNth = false
 		}
-		if c < zeroRune || c > sevenRune {
+		if isStepOrNth || c < zeroRune || c > sevenRune {
 			continue
 		}
 
@@ -183,7 +401,9 @@ func (p *cronParser) normalize(exprParts []string) (err error) {
 			c = zeroRune // Accept 7 means Sunday too
 		} else {
 			if c == zeroRune {
-				return fmt.Errorf("day of week starts at 1, must be from 1 to 7: %w", InvalidExprDayOfWeekError)
+				itemIdx := strings.Count(string(dowRunes[:i]), ",")
+				return tokens.errorAt(FieldDayOfWeek, itemIdx, p.dayOfWeekBounds(),
+					"day of week starts at 1, must be from 1 to 7", InvalidExprDayOfWeekError)
 			}
 			c -= 1 // Day of week start at 1 (Monday), so shift it 1
 		}
@@ -200,21 +420,22 @@ func (p *cronParser) normalize(exprParts []string) (err error) {
 
 	if strings.Index(dayOfMonth, "w") > -1 &&
 		(strings.Index(dayOfMonth, ",") > -1 || strings.Index(dayOfMonth, "-") > -1) {
-		return fmt.Errorf("the 'W' character can be specified only when the day-of-month is a single day, not a range or list of days: %w", InvalidExprDayOfMonthError)
+		return tokens.errorAt(FieldDayOfMonth, -1, fieldBounds[FieldDayOfMonth],
+			"the 'W' character can be specified only when the day-of-month is a single day, not a range or list of days", InvalidExprDayOfMonthError)
 	}
 
 	// Convert DOW SUN-SAT format to 0-6 format
 	for k, v := range days {
-		dayOfWeek = strings.Replace(dayOfWeek, k, strconv.Itoa(v), 1)
+		dayOfWeek = strings.Replace(dayOfWeek, k, strconv.Itoa(v), -1)
 	}
 
 	// Convert DON JAN-DEC format to 1-12 format
 	for k, v := range months {
-		month = strings.Replace(month, k, strconv.Itoa(v), 1)
+		month = strings.Replace(month, k, strconv.Itoa(v), -1)
 	}
 
-	if second == "0" {
-		second = ""
+	if bounds := strings.Split(second, "-"); len(bounds) <= 2 && isZeroDigits(bounds[0]) && isZeroDigits(bounds[len(bounds)-1]) {
+		second = "" // 0, 00 or the self-range 0-0
 	}
 
 	// If time interval or * (every) is specified for seconds or minutes and hours part is
@@ -240,7 +461,8 @@ func (p *cronParser) normalize(exprParts []string) (err error) {
 
 	// Loop through all parts and apply global normalization
 	for i := range exprParts {
-		if exprParts[i] == "*/1" {
+		// Every 1 is *, whatever the leading zeros of the interval (i.e. */01 and 0/1 => *)
+		if strings.HasPrefix(exprParts[i], "*/") && strings.TrimLeft(exprParts[i][2:], "0") == "1" {
 			exprParts[i] = "*"
 		}
 
@@ -257,7 +479,7 @@ func (p *cronParser) normalize(exprParts []string) (err error) {
 			case 5: // Day of week
 				stepRangeThrough = "6"
 			case 6: // Year
-				stepRangeThrough = "2099"
+				stepRangeThrough = strconv.Itoa(maxScheduleYear)
 			}
 
 			if stepRangeThrough == "" {
@@ -270,7 +492,7 @@ func (p *cronParser) normalize(exprParts []string) (err error) {
 	return nil
 }
 
-func (p *cronParser) validate(exprParts []string) (err error) {
+func (p *cronParser) validate(exprParts []string, tokens *exprTokens) (err error) {
 	// Extract the numbers from s string
 	buf := bytes.NewBuffer(make([]byte, 0, 8))
 	getNumbersFunc := func(s string) (numbers []string) {
@@ -292,53 +514,164 @@ func (p *cronParser) validate(exprParts []string) (err error) {
 		return numbers
 	}
 
+	// validateField checks all the items of a field, and returns the error of the first invalid item
+	validateField := func(field FieldType, lowerBound, upperBound int, bounds [2]int, msg string) error {
+		if exprParts[field] == "" {
+			return nil
+		}
+		items := strings.Split(exprParts[field], ",")
+		for i, item := range items {
+			isValid := isValidNumbers(getNumbersFunc(item), lowerBound, upperBound)
+			if field == FieldDayOfMonth || field == FieldDayOfWeek {
+				isValid = isValid && !invalidCharsDOWDOMRegex.MatchString(item)
+			}
+			if !isWellFormed(field, item, len(items) > 1) {
+				isValid = false
+			}
+			if !isValid {
+				return tokens.errorAt(field, i, bounds, msg, fieldErrors[field])
+			}
+		}
+		return nil
+	}
+
 	// Year
 	// Check year first to reduce bound checking
-	matches := getNumbersFunc(exprParts[6])
-	if !isValidNumbers(matches, 1, 2099) {
-		return fmt.Errorf("year contains invalid values: %w", InvalidExprYearError)
+	if err = validateField(FieldYear, minScheduleYear, maxScheduleYear, fieldBounds[FieldYear], "year contains invalid values"); err != nil {
+		return err
 	}
-
 	// Second
-	matches = getNumbersFunc(exprParts[0])
-	if !isValidNumbers(matches, 0, 59) {
-		return fmt.Errorf("second contains invalid values: %w", InvalidExprSecondError)
+	if err = validateField(FieldSecond, 0, 59, fieldBounds[FieldSecond], "second contains invalid values"); err != nil {
+		return err
 	}
 	// Minute
-	matches = getNumbersFunc(exprParts[1])
-	if !isValidNumbers(matches, 0, 59) {
-		return fmt.Errorf("minute contains invalid values: %w", InvalidExprMinuteError)
+	if err = validateField(FieldMinute, 0, 59, fieldBounds[FieldMinute], "minute contains invalid values"); err != nil {
+		return err
 	}
 	// Hour
-	matches = getNumbersFunc(exprParts[2])
-	if !isValidNumbers(matches, 0, 23) {
-		return fmt.Errorf("hour contains invalid values: %w", InvalidExprHourError)
+	if err = validateField(FieldHour, 0, 23, fieldBounds[FieldHour], "hour contains invalid values"); err != nil {
+		return err
 	}
 	// Day of month
-	matches = getNumbersFunc(exprParts[3])
-	if !isValidNumbers(matches, 1, 31) {
-		return fmt.Errorf("DOM contains invalid values: %w", InvalidExprDayOfMonthError)
-	}
-	if invalidCharsDOWDOMRegex.MatchString(exprParts[3]) {
-		return fmt.Errorf("DOM contains invalid values: %w", InvalidExprDayOfMonthError)
+	if err = validateField(FieldDayOfMonth, 1, 31, fieldBounds[FieldDayOfMonth], "DOM contains invalid values"); err != nil {
+		return err
 	}
 	// Month
-	matches = getNumbersFunc(exprParts[4])
-	if !isValidNumbers(matches, 1, 12) {
-		return fmt.Errorf("month contains invalid values: %w", InvalidExprMonthError)
+	if err = validateField(FieldMonth, 1, 12, fieldBounds[FieldMonth], "month contains invalid values"); err != nil {
+		return err
 	}
 	// Day of week
-	matches = getNumbersFunc(exprParts[5])
-	if !isValidNumbers(matches, 0, 6) {
-		return fmt.Errorf("DOW contains invalid values: %w", InvalidExprDayOfWeekError)
+	if err = validateField(FieldDayOfWeek, 0, 6, p.dayOfWeekBounds(), "DOW contains invalid values"); err != nil {
+		return err
 	}
-	if invalidCharsDOWDOMRegex.MatchString(exprParts[5]) { // DOW
-		return fmt.Errorf("DOW contains invalid values: %w", InvalidExprDayOfWeekError)
+
+	if p.isStrictValidation {
+		return p.validateStrict(exprParts, tokens)
+	}
+	return nil
+}
+
+// validateStrict rejects the CRON expressions which are in bounds but make no sense, such as
+// backward ranges, zero steps or schedules that never fire (i.e. February 30th).
+func (p *cronParser) validateStrict(exprParts []string, tokens *exprTokens) (err error) {
+	schedule := &Schedule{}
+	for i, field := range schedule.fields() {
+		typ := FieldType(i)
+		bounds := p.boundsOf(typ)
+		field.Type = typ
+		if exprParts[i] == "" {
+			continue
+		}
+		for j, token := range strings.Split(exprParts[i], ",") {
+			if idx := strings.Index(token, "/"); idx > -1 && strings.Trim(token[idx+1:], "0") == "" {
+				return tokens.errorAt(typ, j, bounds, fmt.Sprintf("%s step must be greater than 0", typ), fieldErrors[typ])
+			}
+
+			item, err := parseItem(typ, token)
+			if err != nil {
+				return tokens.errorAt(typ, j, bounds, fmt.Sprintf("%s contains invalid values", typ), fieldErrors[typ])
+			}
+			if msg := validateItem(typ, item); msg != "" {
+				return tokens.errorAt(typ, j, bounds, msg, fieldErrors[typ])
+			}
+			field.Items = append(field.Items, item)
+		}
 	}
 
+	// Search for the first fire time from the lowest year the schedule can run in
+	fromYear := 2000 // A leap year, so February 29th can fire
+	if len(schedule.Year.Items) > 0 {
+		for fromYear = minScheduleYear; fromYear < maxScheduleYear && !schedule.Year.matches(fromYear, maxScheduleYear); fromYear++ {
+		}
+	}
+	from := time.Date(fromYear, time.January, 1, 0, 0, 0, 0, time.UTC).Add(-time.Second)
+	if schedule.Next(from).IsZero() {
+		return fmt.Errorf("no date matches the day of month, month, day of week and year parts: %w", InvalidExprNeverFiresError)
+	}
 	return nil
 }
 
+// toAnyStep converts the step starting at the first value of the field to a step of *.
+func toAnyStep(part string, first int) string {
+	idx := strings.Index(part, "/")
+	if idx < 1 {
+		return part
+	}
+	if n, err := parseDigits(part[:idx]); err != nil || n != first {
+		return part
+	}
+	return "*" + part[idx:]
+}
+
+// isZeroDigits reports whether the token is 0, with optional leading zeros (i.e. 00).
+func isZeroDigits(token string) bool {
+	n, err := parseDigits(token)
+	return err == nil && n == 0
+}
+
+// isWellFormed reports whether the item is a well-formed item of the field, i.e. not "1-", "L-" or "*" in a list (1,*).
+// The zero steps (i.e. */0) are well-formed, they're only rejected by the strict validation.
+func isWellFormed(typ FieldType, item string, inList bool) bool {
+	if idx := strings.Index(item, "/"); idx > -1 && idx < len(item)-1 && strings.Trim(item[idx+1:], "0") == "" {
+		item = item[:idx+1] + "1"
+	}
+	parsed, err := parseItem(typ, item)
+	if _, isAny := parsed.(Any); isAny && inList {
+		return false
+	}
+	return err == nil
+}
+
+// validateItem returns the reason why the item makes no sense, or empty string if the item is valid.
+func validateItem(typ FieldType, item Item) string {
+	switch it := item.(type) {
+	case Range:
+		// 7 (Sunday) is normalized to 0, so a day of week range can end at 0 (i.e. 5-7 => 5-0)
+		if !it.ToLast && it.From > it.To && !(typ == FieldDayOfWeek && it.To == 0) {
+			return fmt.Sprintf("%s range must not be backwards", typ)
+		}
+	case Step:
+		return validateItem(typ, it.Base)
+	case NthWeekday:
+		if it.N < 1 || it.N > 5 {
+			return "nth day of week must be from 1 to 5"
+		}
+	case LastOffset:
+		if it.Offset > 30 {
+			return "last day offset must be from 0 to 30"
+		}
+	}
+	return ""
+}
+
+// dayOfWeekBounds returns the range of day of week values allowed in the original CRON expression.
+func (p *cronParser) dayOfWeekBounds() [2]int {
+	if p.isDOWStartsAtOne {
+		return [2]int{1, 7}
+	}
+	return [2]int{0, 7}
+}
+
 // isValidNumbers checks if all the numbers in the list is in range (lowerBound, upperBound).
 func isValidNumbers(matches []string, lowerBound, upperBound int) bool {
 	for _, m := range matches {
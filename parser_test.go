@@ -57,6 +57,88 @@ func TestCronParser_Parse(t *testing.T) {
 			inExpr:   "sdlksCRAPdlkskl- dds",
 			outExprs: nil,
 			outErr:   InvalidExprError,
+		}, {
+			name:     "should expand macro",
+			inExpr:   "@weekly",
+			outExprs: []string{"", "0", "0", "*", "*", "0", ""},
+			outErr:   nil,
+		}, {
+			name:                 "should expand macro when DOW starts at one",
+			inTestDOWStartsAtOne: true,
+			inExpr:               " @Weekly ",
+			outExprs:             []string{"", "0", "0", "*", "*", "0", ""},
+			outErr:               nil,
+		}, {
+			name:     "should expand yearly macro",
+			inExpr:   "@yearly",
+			outExprs: []string{"", "0", "0", "1", "1", "*", ""},
+			outErr:   nil,
+		}, {
+			name:                 "should shift each day of week of the list when DOW starts at one",
+			inTestDOWStartsAtOne: true,
+			inExpr:               "0 9 * * 2/2,6#3,5L,7",
+			outExprs:             []string{"", "0", "9", "*", "*", "1/2,5#3,4l,6", ""},
+			outErr:               nil,
+		}, {
+			name:     "should error on unknown macro",
+			inExpr:   "@fortnightly",
+			outExprs: nil,
+			outErr:   InvalidExprError,
+		}, {
+			name:     "should error on empty cron()",
+			inExpr:   "cron()",
+			outExprs: nil,
+			outErr:   InvalidExprError,
+		}, {
+			name:     "should error on malformed last day offset",
+			inExpr:   "0 0 L- * *",
+			outExprs: nil,
+			outErr:   InvalidExprDayOfMonthError,
+		}, {
+			name:     "should error on malformed nth day of week",
+			inExpr:   "0 0 * * #",
+			outExprs: nil,
+			outErr:   InvalidExprDayOfWeekError,
+		}, {
+			name:     "should error on negative range end",
+			inExpr:   "0 0 * 1--1 *",
+			outExprs: nil,
+			outErr:   InvalidExprMonthError,
+		}, {
+			name:     "should error on any in a list",
+			inExpr:   "00000 0 1 1,* 0",
+			outExprs: nil,
+			outErr:   InvalidExprMonthError,
+		}, {
+			name:     "should normalize every 1 with leading zeros",
+			inExpr:   "0/01 0 1 1 0",
+			outExprs: []string{"", "*", "0-0", "1", "1", "0", ""},
+			outErr:   nil,
+		}, {
+			name:     "should parse repeated month names",
+			inExpr:   "0 0 * jan,jan *",
+			outExprs: []string{"", "0", "0", "*", "1,1", "*", ""},
+			outErr:   nil,
+		}, {
+			name:     "should normalize zero second with leading zero",
+			inExpr:   "00 0 0 1 1 0",
+			outExprs: []string{"", "0", "0", "1", "1", "0", ""},
+			outErr:   nil,
+		}, {
+			name:     "should error on macro with extra parts",
+			inExpr:   "@daily *",
+			outExprs: nil,
+			outErr:   InvalidExprError,
+		}, {
+			name:     "should error on @reboot since it has no time fields",
+			inExpr:   "@reboot",
+			outExprs: nil,
+			outErr:   InvalidExprError,
+		}, {
+			name:     "should error on @every since it has no time fields",
+			inExpr:   "@every 5m",
+			outExprs: nil,
+			outErr:   InvalidExprError,
 		},
 
 		// normalize
@@ -142,6 +224,11 @@ func TestCronParser_Parse(t *testing.T) {
 			inExpr:   "5 * * 3/5 2/5 2/2 2000/10",
 			outExprs: []string{"5", "*", "*", "3/5", "2-12/5", "2-6/2", "2000-2099/10"},
 			outErr:   nil,
+		}, {
+			name:     "should not normalize range step to range",
+			inExpr:   "0 * * * * 1-5/2 2000-2050/10",
+			outExprs: []string{"", "*", "*", "*", "*", "1-5/2", "2000-2050/10"},
+			outErr:   nil,
 		},
 
 		// validate
@@ -264,6 +351,140 @@ func TestCronParser_Parse(t *testing.T) {
 	}
 }
 
+func TestCronParser_ParseError(t *testing.T) {
+	type testCase struct {
+		name                 string
+		inTestDOWStartsAtOne bool
+		inExpr               string
+		outErr               error
+		outParseErr          ParseError
+	}
+
+	tcs := []testCase{
+		{
+			name:        "should point at invalid second",
+			inExpr:      "60 * * * * * *",
+			outErr:      InvalidExprSecondError,
+			outParseErr: ParseError{Field: FieldSecond, Offset: 0, Token: "60", Min: 0, Max: 59},
+		}, {
+			name:        "should point at invalid item in list",
+			inExpr:      "  5,10,75 * * * *",
+			outErr:      InvalidExprMinuteError,
+			outParseErr: ParseError{Field: FieldMinute, Offset: 7, Token: "75", Min: 0, Max: 59},
+		}, {
+			name:        "should point at invalid hour",
+			inExpr:      "* 0-24 * * *",
+			outErr:      InvalidExprHourError,
+			outParseErr: ParseError{Field: FieldHour, Offset: 2, Token: "0-24", Min: 0, Max: 23},
+		}, {
+			name:        "should point at invalid DOM characters",
+			inExpr:      "* * 1,LX * *",
+			outErr:      InvalidExprDayOfMonthError,
+			outParseErr: ParseError{Field: FieldDayOfMonth, Offset: 6, Token: "LX", Min: 1, Max: 31},
+		}, {
+			name:        "should point at DOM 'W' with list of dates",
+			inExpr:      "* * 1,2,5W * *",
+			outErr:      InvalidExprDayOfMonthError,
+			outParseErr: ParseError{Field: FieldDayOfMonth, Offset: 4, Token: "1,2,5W", Min: 1, Max: 31},
+		}, {
+			name:        "should point at invalid month in original case",
+			inExpr:      "* * * JAN,13 *",
+			outErr:      InvalidExprMonthError,
+			outParseErr: ParseError{Field: FieldMonth, Offset: 10, Token: "13", Min: 1, Max: 12},
+		}, {
+			name:        "should point at invalid DOW",
+			inExpr:      "* * * * MON,8",
+			outErr:      InvalidExprDayOfWeekError,
+			outParseErr: ParseError{Field: FieldDayOfWeek, Offset: 12, Token: "8", Min: 0, Max: 7},
+		}, {
+			name:                 "should point at DOW 0 when DOW starts at one",
+			inTestDOWStartsAtOne: true,
+			inExpr:               "* * * * 1,0-3",
+			outErr:               InvalidExprDayOfWeekError,
+			outParseErr:          ParseError{Field: FieldDayOfWeek, Offset: 10, Token: "0-3", Min: 1, Max: 7},
+		}, {
+			name:        "should point at invalid year",
+			inExpr:      "0 0 12 * * ? 2100",
+			outErr:      InvalidExprYearError,
+			outParseErr: ParseError{Field: FieldYear, Offset: 13, Token: "2100", Min: 1, Max: 2099},
+		},
+	}
+
+	parser := cronParser{}
+
+	for i, tc := range tcs {
+		parser.isDOWStartsAtOne = tc.inTestDOWStartsAtOne
+
+		_, err := parser.Parse(tc.inExpr)
+		if !errors.Is(err, tc.outErr) {
+			t.Errorf("%d. %s: expected '%v' error, got '%v'", i, tc.name, tc.outErr, err)
+			continue
+		}
+
+		var parseErr *ParseError
+		if !errors.As(err, &parseErr) {
+			t.Errorf("%d. %s: expected *ParseError, got '%T'", i, tc.name, err)
+			continue
+		}
+		tc.outParseErr.Err = tc.outErr
+		tc.outParseErr.msg = parseErr.msg
+		if *parseErr != tc.outParseErr {
+			t.Errorf("%d. %s: expected '%+v', got '%+v'", i, tc.name, tc.outParseErr, *parseErr)
+		}
+		if got := tc.inExpr[parseErr.Offset : parseErr.Offset+len(parseErr.Token)]; got != parseErr.Token {
+			t.Errorf("%d. %s: expected token '%s' at offset %d, got '%s'", i, tc.name, parseErr.Token, parseErr.Offset, got)
+		}
+	}
+}
+
+func TestCronParser_StrictValidation(t *testing.T) {
+	type testCase struct {
+		name     string
+		inStrict bool
+		inExpr   string
+		outErr   error
+	}
+
+	tcs := []testCase{
+		{name: "should accept impossible date when not strict", inStrict: false, inExpr: "0 0 30 2 *", outErr: nil},
+		{name: "should accept backward range when not strict", inStrict: false, inExpr: "5-2 * * * *", outErr: nil},
+		{name: "should accept zero step when not strict", inStrict: false, inExpr: "*/0 * * * *", outErr: nil},
+		{name: "should reject February 30th", inStrict: true, inExpr: "0 0 30 2 *", outErr: InvalidExprNeverFiresError},
+		{name: "should reject 31st in short months", inStrict: true, inExpr: "0 0 31 4,6,9,11 *", outErr: InvalidExprNeverFiresError},
+		{name: "should reject February 29th in non leap year", inStrict: true, inExpr: "0 0 29 2 * 2027", outErr: InvalidExprNeverFiresError},
+		{name: "should accept 31st when a month has 31 days", inStrict: true, inExpr: "0 0 31 4,5 *", outErr: nil},
+		{name: "should accept February 29th", inStrict: true, inExpr: "0 0 29 2 *", outErr: nil},
+		{name: "should accept February 29th in leap year", inStrict: true, inExpr: "0 0 29 2 * 2028", outErr: nil},
+		{name: "should accept impossible DOM when DOW is specified", inStrict: true, inExpr: "0 0 30 2 1", outErr: nil},
+		{name: "should reject backward range", inStrict: true, inExpr: "5-2 * * * *", outErr: InvalidExprMinuteError},
+		{name: "should reject backward range step", inStrict: true, inExpr: "* 20-10/2 * * *", outErr: InvalidExprHourError},
+		{name: "should accept range ending on Sunday", inStrict: true, inExpr: "* * * * 5-7", outErr: nil},
+		{name: "should reject zero step", inStrict: true, inExpr: "*/0 * * * *", outErr: InvalidExprMinuteError},
+		{name: "should reject zero step in range", inStrict: true, inExpr: "* * 1-10/0 * *", outErr: InvalidExprDayOfMonthError},
+		{name: "should reject sixth weekday of month", inStrict: true, inExpr: "* * * * 5#6", outErr: InvalidExprDayOfWeekError},
+		{name: "should reject zeroth weekday of month", inStrict: true, inExpr: "* * * * 5#0", outErr: InvalidExprDayOfWeekError},
+		{name: "should reject last day offset out of bounds", inStrict: true, inExpr: "* * L-40 * *", outErr: InvalidExprDayOfMonthError},
+		{name: "should reject last day offset of 31 days", inStrict: true, inExpr: "* * L-31 * *", outErr: InvalidExprDayOfMonthError},
+		{name: "should reject malformed last day offset", inStrict: true, inExpr: "* * L- * *", outErr: InvalidExprDayOfMonthError},
+		{name: "should accept valid expression", inStrict: true, inExpr: "0 15 10 ? * 6L 2002-2005", outErr: nil},
+	}
+
+	for i, tc := range tcs {
+		parser := NewParser(StrictValidation(tc.inStrict))
+
+		_, err := parser.Parse(tc.inExpr)
+		if tc.outErr == nil {
+			if err != nil {
+				t.Errorf("%d. %s: expected nil, got error '%v'", i, tc.name, err)
+			}
+			continue
+		}
+		if !errors.Is(err, tc.outErr) {
+			t.Errorf("%d. %s: expected '%v' error, got '%v'", i, tc.name, tc.outErr, err)
+		}
+	}
+}
+
 var _parsed []string
 
 func BenchmarkCronParser_Parse(b *testing.B) {
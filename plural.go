@@ -0,0 +1,217 @@
+package cron
+
+import (
+	"strconv"
+	"strings"
+)
+
+// PluralCategory is the CLDR plural category of a count, which picks the grammatical form of a locale string,
+// i.e. "every %s minute" (one) or "every %s minutes" (other) in English.
+type PluralCategory string
+
+const (
+	PluralZero  PluralCategory = "zero"
+	PluralOne   PluralCategory = "one"
+	PluralTwo   PluralCategory = "two"
+	PluralFew   PluralCategory = "few"
+	PluralMany  PluralCategory = "many"
+	PluralOther PluralCategory = "other"
+)
+
+var (
+	pluralCategories = map[PluralCategory]bool{
+		PluralZero:  true,
+		PluralOne:   true,
+		PluralTwo:   true,
+		PluralFew:   true,
+		PluralMany:  true,
+		PluralOther: true,
+	}
+
+	// pluralRules are the CLDR cardinal plural rules of the integer counts, by language.
+	// The languages which are not listed use pluralRuleOne.
+	pluralRules = map[string]func(n int) PluralCategory{
+		"cs": pluralRuleWestSlavic,
+		"fa": pluralRuleZeroOne,
+		"fr": pluralRuleZeroOne,
+		"he": pluralRuleHebrew,
+		"ja": pluralRuleNone,
+		"ko": pluralRuleNone,
+		"pl": pluralRulePolish,
+		"pt": pluralRuleZeroOne,
+		"ro": pluralRuleRomanian,
+		"ru": pluralRuleEastSlavic,
+		"sk": pluralRuleWestSlavic,
+		"sl": pluralRuleSlovenian,
+		"uk": pluralRuleEastSlavic,
+		"zh": pluralRuleNone,
+	}
+
+	// ordinalRules are the CLDR ordinal plural rules, which pick the form of the ordinal numbers (see Locale.GetOrdinal).
+	// The languages which are not listed use pluralRuleNone.
+	ordinalRules = map[string]func(n int) PluralCategory{
+		"en": ordinalRuleEnglish,
+		"fr": ordinalRuleOne,
+		"sv": ordinalRuleSwedish,
+	}
+)
+
+// PluralCategoryOf returns the CLDR plural category of the count n in the language of the locale.
+func PluralCategoryOf(typ LocaleType, n int) PluralCategory {
+	if n < 0 {
+		n = -n
+	}
+	if rule, ok := pluralRules[localeLanguage(typ)]; ok {
+		return rule(n)
+	}
+	return pluralRuleOne(n)
+}
+
+// OrdinalCategoryOf returns the CLDR ordinal plural category of n in the language of the locale,
+// i.e. PluralTwo for 22 ("22nd") in English.
+func OrdinalCategoryOf(typ LocaleType, n int) PluralCategory {
+	if n < 0 {
+		n = -n
+	}
+	if rule, ok := ordinalRules[localeLanguage(typ)]; ok {
+		return rule(n)
+	}
+	return pluralRuleNone(n)
+}
+
+// localeLanguage returns the language of the locale type, i.e. "pt" for "pt_BR".
+func localeLanguage(typ LocaleType) string {
+	lang := strings.ToLower(string(typ))
+	if idx := strings.IndexAny(lang, "_-"); idx > -1 {
+		lang = lang[:idx]
+	}
+	return lang
+}
+
+// pluralFormat returns the plural form of the locale string for the count in s (i.e. the interval of "*/5"),
+// or the locale string if s is not a count.
+func pluralFormat(locale Locale, key LocaleKey, s string) string {
+	count, err := strconv.Atoi(s)
+	if err != nil {
+		return locale.GetString(key)
+	}
+	return locale.GetPluralString(key, count)
+}
+
+// pluralRuleOne is the rule of English and most of the European languages: 1 is one, the others are other.
+func pluralRuleOne(n int) PluralCategory {
+	if n == 1 {
+		return PluralOne
+	}
+	return PluralOther
+}
+
+// pluralRuleNone is the rule of the languages which don't inflect by count (i.e. Japanese).
+func pluralRuleNone(n int) PluralCategory {
+	return PluralOther
+}
+
+// pluralRuleZeroOne is the rule of French, Portuguese and Farsi: 0 and 1 are one.
+func pluralRuleZeroOne(n int) PluralCategory {
+	if n == 0 || n == 1 {
+		return PluralOne
+	}
+	return PluralOther
+}
+
+// pluralRuleHebrew: 1 is one, 2 is two.
+func pluralRuleHebrew(n int) PluralCategory {
+	switch n {
+	case 1:
+		return PluralOne
+	case 2:
+		return PluralTwo
+	}
+	return PluralOther
+}
+
+// pluralRuleEastSlavic is the rule of Russian and Ukrainian: 1, 21, 31... are one, 2-4, 22-24... are few.
+func pluralRuleEastSlavic(n int) PluralCategory {
+	switch {
+	case n%10 == 1 && n%100 != 11:
+		return PluralOne
+	case n%10 >= 2 && n%10 <= 4 && (n%100 < 12 || n%100 > 14):
+		return PluralFew
+	}
+	return PluralMany
+}
+
+// pluralRulePolish: 1 is one, 2-4, 22-24... are few, the others (i.e. 21) are many.
+func pluralRulePolish(n int) PluralCategory {
+	switch {
+	case n == 1:
+		return PluralOne
+	case n%10 >= 2 && n%10 <= 4 && (n%100 < 12 || n%100 > 14):
+		return PluralFew
+	}
+	return PluralMany
+}
+
+// pluralRuleWestSlavic is the rule of Czech and Slovak: 1 is one, 2-4 are few.
+func pluralRuleWestSlavic(n int) PluralCategory {
+	switch {
+	case n == 1:
+		return PluralOne
+	case n >= 2 && n <= 4:
+		return PluralFew
+	}
+	return PluralOther
+}
+
+// pluralRuleSlovenian: 1, 101... are one, 2, 102... are two, 3-4, 103-104... are few.
+func pluralRuleSlovenian(n int) PluralCategory {
+	switch n % 100 {
+	case 1:
+		return PluralOne
+	case 2:
+		return PluralTwo
+	case 3, 4:
+		return PluralFew
+	}
+	return PluralOther
+}
+
+// pluralRuleRomanian: 1 is one, 0 and 2-19, 101-119... are few.
+func pluralRuleRomanian(n int) PluralCategory {
+	switch {
+	case n == 1:
+		return PluralOne
+	case n == 0 || (n%100 >= 1 && n%100 <= 19):
+		return PluralFew
+	}
+	return PluralOther
+}
+
+// ordinalRuleEnglish: 1, 21... are one (1st), 2, 22... are two (2nd), 3, 23... are few (3rd), 11-13 are other (11th).
+func ordinalRuleEnglish(n int) PluralCategory {
+	switch {
+	case n%10 == 1 && n%100 != 11:
+		return PluralOne
+	case n%10 == 2 && n%100 != 12:
+		return PluralTwo
+	case n%10 == 3 && n%100 != 13:
+		return PluralFew
+	}
+	return PluralOther
+}
+
+// ordinalRuleOne is the ordinal rule of French: 1 is one (1er).
+func ordinalRuleOne(n int) PluralCategory {
+	if n == 1 {
+		return PluralOne
+	}
+	return PluralOther
+}
+
+// ordinalRuleSwedish: 1, 2, 21, 22... are one (1:a, 2:a), 11 and 12 are other (11:e).
+func ordinalRuleSwedish(n int) PluralCategory {
+	if (n%10 == 1 || n%10 == 2) && n%100 != 11 && n%100 != 12 {
+		return PluralOne
+	}
+	return PluralOther
+}
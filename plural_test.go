@@ -0,0 +1,127 @@
+package cron
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/lnquy/cron/i18n"
+)
+
+func TestPluralCategoryOf(t *testing.T) {
+	type testCase struct {
+		inLocale LocaleType
+		inCounts []int
+		out      []PluralCategory
+	}
+
+	tcs := []testCase{
+		{inLocale: Locale_en, inCounts: []int{0, 1, 2, 21, -1}, out: []PluralCategory{PluralOther, PluralOne, PluralOther, PluralOther, PluralOne}},
+		{inLocale: Locale_fr, inCounts: []int{0, 1, 2}, out: []PluralCategory{PluralOne, PluralOne, PluralOther}},
+		{inLocale: Locale_pt_BR, inCounts: []int{0, 1, 2}, out: []PluralCategory{PluralOne, PluralOne, PluralOther}},
+		{inLocale: Locale_ja, inCounts: []int{1, 2}, out: []PluralCategory{PluralOther, PluralOther}},
+		{inLocale: Locale_he, inCounts: []int{1, 2, 3}, out: []PluralCategory{PluralOne, PluralTwo, PluralOther}},
+		{
+			inLocale: Locale_ru,
+			inCounts: []int{1, 2, 5, 11, 12, 21, 22, 25, 101, 111},
+			out:      []PluralCategory{PluralOne, PluralFew, PluralMany, PluralMany, PluralMany, PluralOne, PluralFew, PluralMany, PluralOne, PluralMany},
+		},
+		{
+			inLocale: Locale_pl,
+			inCounts: []int{1, 2, 5, 12, 21, 22, 25},
+			out:      []PluralCategory{PluralOne, PluralFew, PluralMany, PluralMany, PluralMany, PluralFew, PluralMany},
+		},
+		{
+			inLocale: Locale_cs,
+			inCounts: []int{1, 2, 4, 5, 22},
+			out:      []PluralCategory{PluralOne, PluralFew, PluralFew, PluralOther, PluralOther},
+		},
+		{
+			inLocale: Locale_sl,
+			inCounts: []int{1, 2, 3, 5, 101, 102, 104},
+			out:      []PluralCategory{PluralOne, PluralTwo, PluralFew, PluralOther, PluralOne, PluralTwo, PluralFew},
+		},
+		{
+			inLocale: Locale_ro,
+			inCounts: []int{0, 1, 2, 19, 20, 101, 119, 120},
+			out:      []PluralCategory{PluralFew, PluralOne, PluralFew, PluralFew, PluralOther, PluralFew, PluralFew, PluralOther},
+		},
+	}
+
+	for i, tc := range tcs {
+		for j, count := range tc.inCounts {
+			if got := PluralCategoryOf(tc.inLocale, count); got != tc.out[j] {
+				t.Errorf("%d. %s: expected '%s' for %d, got '%s'", i, tc.inLocale, tc.out[j], count, got)
+			}
+		}
+	}
+}
+
+func TestExpressionDescriptor_ToDescription_Plural(t *testing.T) {
+	type testCase struct {
+		inLocale LocaleType
+		inExpr   string
+		out      string
+	}
+
+	tcs := []testCase{
+		{inLocale: Locale_en, inExpr: "1 * * * *", out: "At 1 minute past the hour"},
+		{inLocale: Locale_en, inExpr: "*/2 * * * *", out: "Every 2 minutes"},
+		{inLocale: Locale_ru, inExpr: "*/2 * * * *", out: "Каждые 2 минуты"},
+		{inLocale: Locale_ru, inExpr: "*/5 * * * *", out: "Каждые 5 минут"},
+		{inLocale: Locale_ru, inExpr: "*/21 * * * *", out: "Каждую 21 минуту"},
+		{inLocale: Locale_ru, inExpr: "0 0 */3 * *", out: "В 12:00 AM, каждые 3 дня"},
+		{inLocale: Locale_uk, inExpr: "*/21 * * * *", out: "Кожну 21 хвилину"},
+		{inLocale: Locale_pl, inExpr: "*/2 * * * *", out: "Co 2 minuty"},
+		{inLocale: Locale_pl, inExpr: "*/21 * * * *", out: "Co 21 minut"},
+		{inLocale: Locale_cs, inExpr: "*/2 * * * *", out: "Každé 2 minuty"},
+		{inLocale: Locale_cs, inExpr: "*/5 * * * *", out: "Každých 5 minut"},
+		{inLocale: Locale_sk, inExpr: "0 0 */3 * *", out: "V 12:00 AM, každé 3 dni"},
+		{inLocale: Locale_sl, inExpr: "*/2 * * * *", out: "Vsaki 2 minuti"},
+		{inLocale: Locale_sl, inExpr: "*/5 * * * *", out: "Vsakih 5 minut"},
+	}
+
+	exprDesc, err := NewDescriptor(SetLocales(LocaleAll))
+	if err != nil {
+		t.Fatalf("failed to create expression descriptor: %s", err)
+	}
+	for i, tc := range tcs {
+		desc, err := exprDesc.ToDescription(tc.inExpr, tc.inLocale)
+		if err != nil {
+			t.Errorf("%d. %s: expected nil, got error '%v'", i, tc.inExpr, err)
+			continue
+		}
+		if desc != tc.out {
+			t.Errorf("%d. %s: expected '%s', got '%s'", i, tc.inExpr, tc.out, desc)
+		}
+	}
+}
+
+func TestLoadLocaleFromReader_Plural(t *testing.T) {
+	data := strings.Replace(i18n.Locale_de, `"everyX0Minutes": "alle %s Minuten"`,
+		`"everyX0Minutes": {"one": "jede %s Minute", "other": "alle %s Minuten"}`, 1)
+	yamlData := toLocaleYAML(t, i18n.Locale_de, map[string]interface{}{
+		"everyX0Minutes": map[string]interface{}{"one": "jede %s Minute", "other": "alle %s Minuten"},
+	})
+
+	for _, doc := range []string{data, yamlData} {
+		locale, err := LoadLocaleFromReader(strings.NewReader(doc), "de_test")
+		if err != nil {
+			t.Fatalf("failed to load locale: %s", err)
+		}
+		if got := locale.GetPluralString(everyX0Minutes, 1); got != "jede %s Minute" {
+			t.Errorf("expected 'jede %%s Minute', got '%s'", got)
+		}
+		if got := locale.GetPluralString(everyX0Minutes, 5); got != "alle %s Minuten" {
+			t.Errorf("expected 'alle %%s Minuten', got '%s'", got)
+		}
+		if got := locale.GetString(everyX0Minutes); got != "alle %s Minuten" {
+			t.Errorf("expected 'alle %%s Minuten', got '%s'", got)
+		}
+	}
+
+	invalid := strings.Replace(i18n.Locale_de, `"everyX0Minutes": "alle %s Minuten"`,
+		`"everyX0Minutes": {"one": "jede %s Minute", "plenty": "alle %s Minuten"}`, 1)
+	if _, err := LoadLocaleFromReader(strings.NewReader(invalid), "de_test"); err == nil {
+		t.Errorf("expected invalid plural forms error, got nil")
+	}
+}
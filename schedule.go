@@ -0,0 +1,317 @@
+package cron
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// searchYears is the number of years Next and Prev search before giving up.
+	// The Gregorian calendar repeats every 400 years, so a schedule that doesn't fire
+	// within this window never fires.
+	searchYears = 400
+
+	// minScheduleYear and maxScheduleYear are the bounds of the year field, as parsed and as matched.
+	// The steps of * start at year 1, the same as 1/n.
+	minScheduleYear = 1
+	maxScheduleYear = 2099
+)
+
+// Schedule is the typed model of a CRON expression, with one Field per unit.
+// A Schedule is built from the normalized 7-part CRON expression returned by Parser.Parse.
+type Schedule struct {
+	Second     Field
+	Minute     Field
+	Hour       Field
+	DayOfMonth Field
+	Month      Field
+	DayOfWeek  Field
+	Year       Field
+
+	// Location is the time zone the schedule fires in (i.e. of the CRON_TZ= prefix), nil if the schedule
+	// fires in the location of the times passed to Next and Prev.
+	Location *time.Location
+
+	// Dialect, Layout and DayOfWeekStartsAtOne are the notation String writes the expression in,
+	// so the canonical expression parses back with the descriptor the schedule was parsed with.
+	Dialect              Dialect
+	Layout               FieldLayout
+	DayOfWeekStartsAtOne bool
+}
+
+// NewSchedule builds the Schedule from the normalized 7-part CRON expression returned by Parser.Parse.
+func NewSchedule(exprParts []string) (schedule *Schedule, err error) {
+	if len(exprParts) != 7 {
+		return nil, fmt.Errorf("expression has %d parts, 7 parts required: %w", len(exprParts), InvalidExprError)
+	}
+
+	schedule = &Schedule{}
+	for i, field := range schedule.fields() {
+		if *field, err = parseField(FieldType(i), exprParts[i]); err != nil {
+			return nil, err
+		}
+	}
+	return schedule, nil
+}
+
+// ToSchedule parses the CRON expression and returns its typed Schedule.
+func (e *ExpressionDescriptor) ToSchedule(expr string) (schedule *Schedule, err error) {
+	exprParts, tokens, err := e.parser.parse(expr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse CRON expression: %w", err)
+	}
+	if schedule, err = NewSchedule(exprParts); err != nil {
+		return nil, err
+	}
+	schedule.Location = tokens.location
+	schedule.Dialect = tokens.dialect
+	schedule.Layout = e.parser.fieldLayout
+	schedule.DayOfWeekStartsAtOne = e.parser.isDOWStartsAtOne || tokens.dialect.isDOWStartsAtOne()
+	return schedule, nil
+}
+
+// ToInterval returns the interval of the CRON expression which has no time fields (@every <duration> and rate()),
+// i.e. 90m for "@every 1h30m", or 0 for @reboot which only runs at system startup.
+// The expressions which have time fields return an error, see ToSchedule.
+func (e *ExpressionDescriptor) ToInterval(expr string) (every time.Duration, err error) {
+	special, err := e.parser.parseSpecial(expr)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse CRON expression: %w", err)
+	}
+	if special == nil {
+		return 0, fmt.Errorf("%s has time fields, see ToSchedule: %w", strings.TrimSpace(expr), InvalidExprError)
+	}
+	return special.every, nil
+}
+
+// Fields returns the 7 fields of the schedule, ordered by FieldType.
+func (s *Schedule) Fields() []Field {
+	fields := make([]Field, 0, 7)
+	for _, field := range s.fields() {
+		fields = append(fields, *field)
+	}
+	return fields
+}
+
+// String returns the canonical CRON expression of the schedule, which parses back to the same schedule.
+// The second and year fields are only included when specified (or required by the layout), the days of week
+// are numbered from 1 if DayOfWeekStartsAtOne, and the location is written as a CRON_TZ= prefix when set.
+func (s *Schedule) String() string {
+	var parts [7]string
+	for i, field := range s.fields() {
+		if field.Type == FieldDayOfWeek && s.DayOfWeekStartsAtOne {
+			items := make([]Item, 0, len(field.Items))
+			for _, item := range field.Items {
+				items = append(items, oneBasedWeekday(item))
+			}
+			field = &Field{Type: FieldDayOfWeek, Items: items}
+		}
+		parts[i] = field.String()
+		if parts[i] == "" && field.Type != FieldSecond && field.Type != FieldYear {
+			parts[i] = "*"
+		}
+	}
+
+	var expr []string
+	layouts := s.Dialect.layouts()
+	if s.Layout != LayoutAuto {
+		layouts = []FieldLayout{s.Layout}
+	}
+	switch {
+	case len(layouts) > 0:
+		if s.Dialect.requiresQuestionMark() {
+			setQuestionMark(&parts)
+		}
+		for _, typ := range pickLayout(layouts, parts[FieldSecond] != "", parts[FieldYear] != "").fields() {
+			switch {
+			case parts[typ] != "":
+				expr = append(expr, parts[typ])
+			case typ == FieldSecond:
+				expr = append(expr, "0")
+			default:
+				expr = append(expr, "*")
+			}
+		}
+	case parts[FieldSecond] == "" && parts[FieldYear] == "":
+		expr = parts[1:6]
+	case parts[FieldYear] == "":
+		expr = parts[:6]
+	default:
+		if parts[FieldSecond] == "" {
+			parts[FieldSecond] = "0" // Year without second is ambiguous, so always output 7 parts
+		}
+		expr = parts[:]
+	}
+	if s.Location != nil {
+		return "CRON_TZ=" + s.Location.String() + " " + strings.Join(expr, " ")
+	}
+	return strings.Join(expr, " ")
+}
+
+func (s *Schedule) fields() [7]*Field {
+	return [7]*Field{&s.Second, &s.Minute, &s.Hour, &s.DayOfMonth, &s.Month, &s.DayOfWeek, &s.Year}
+}
+
+// Next returns the first fire time of the schedule after t, in the location of t.
+// If the schedule never fires after t, the zero time is returned.
+func (s *Schedule) Next(t time.Time) time.Time {
+	if s.Location != nil && t.Location() != s.Location {
+		return inLocation(s.Next(t.In(s.Location)), t.Location())
+	}
+	loc := t.Location()
+	start := t.Truncate(time.Second).Add(time.Second)
+	startYear, startMonth, startDay := start.Date()
+
+	seconds := s.Second.values()
+	minutes := s.Minute.values()
+	hours := s.Hour.values()
+
+	y, m, d := startYear, int(startMonth), startDay
+	for y <= startYear+searchYears {
+		if !s.Year.matches(y, maxScheduleYear) {
+			y, m, d = y+1, 1, 1
+			continue
+		}
+		if !s.Month.matches(m, 12) {
+			y, m, d = nextMonth(y, m)
+			continue
+		}
+		if d > daysIn(y, m) {
+			y, m, d = nextMonth(y, m)
+			continue
+		}
+		if s.matchesDay(y, m, d) {
+			isStartDay := y == startYear && m == int(startMonth) && d == startDay
+			for _, hour := range hours {
+				if isStartDay && hour < start.Hour() {
+					continue
+				}
+				for _, minute := range minutes {
+					if isStartDay && hour == start.Hour() && minute < start.Minute() {
+						continue
+					}
+					for _, second := range seconds {
+						next := time.Date(y, time.Month(m), d, hour, minute, second, 0, loc)
+						if isStartDay && next.Before(start) {
+							continue
+						}
+						if next.Hour() != hour || next.Minute() != minute {
+							continue // Wall clock time skipped by a DST transition
+						}
+						return next
+					}
+				}
+			}
+		}
+		d++
+	}
+	return time.Time{}
+}
+
+// Prev returns the last fire time of the schedule before t, in the location of t.
+// If the schedule never fired before t, the zero time is returned.
+func (s *Schedule) Prev(t time.Time) time.Time {
+	if s.Location != nil && t.Location() != s.Location {
+		return inLocation(s.Prev(t.In(s.Location)), t.Location())
+	}
+	loc := t.Location()
+	start := t.Truncate(time.Second)
+	if start.Equal(t) {
+		start = start.Add(-time.Second)
+	}
+	startYear, startMonth, startDay := start.Date()
+
+	seconds := reversed(s.Second.values())
+	minutes := reversed(s.Minute.values())
+	hours := reversed(s.Hour.values())
+
+	y, m, d := startYear, int(startMonth), startDay
+	for y >= startYear-searchYears && y > 0 {
+		if !s.Year.matches(y, maxScheduleYear) {
+			y, m, d = y-1, 12, 31
+			continue
+		}
+		if !s.Month.matches(m, 12) {
+			y, m, d = prevMonth(y, m)
+			continue
+		}
+		if d < 1 {
+			y, m, d = prevMonth(y, m)
+			continue
+		}
+		if s.matchesDay(y, m, d) {
+			isStartDay := y == startYear && m == int(startMonth) && d == startDay
+			for _, hour := range hours {
+				if isStartDay && hour > start.Hour() {
+					continue
+				}
+				for _, minute := range minutes {
+					if isStartDay && hour == start.Hour() && minute > start.Minute() {
+						continue
+					}
+					for _, second := range seconds {
+						prev := time.Date(y, time.Month(m), d, hour, minute, second, 0, loc)
+						if isStartDay && prev.After(start) {
+							continue
+						}
+						if prev.Hour() != hour || prev.Minute() != minute {
+							continue // Wall clock time skipped by a DST transition
+						}
+						return prev
+					}
+				}
+			}
+		}
+		d--
+	}
+	return time.Time{}
+}
+
+// matchesDay reports whether the schedule fires on the given day.
+// As in Vixie cron, if either day of month or day of week starts with '*' then both must match,
+// otherwise the schedule fires when either matches.
+func (s *Schedule) matchesDay(year, month, day int) bool {
+	lastDay := daysIn(year, month)
+	weekday := int(time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC).Weekday())
+
+	domMatched := s.DayOfMonth.matchesDayOfMonth(year, month, day, lastDay)
+	dowMatched := s.DayOfWeek.matchesDayOfWeek(day, weekday, lastDay)
+	if s.DayOfMonth.isStar() || s.DayOfWeek.isStar() {
+		return domMatched && dowMatched
+	}
+	return domMatched || dowMatched
+}
+
+// inLocation returns t in the location, or the zero time if t is zero.
+func inLocation(t time.Time, loc *time.Location) time.Time {
+	if t.IsZero() {
+		return t
+	}
+	return t.In(loc)
+}
+
+func daysIn(year, month int) int {
+	return time.Date(year, time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func nextMonth(year, month int) (int, int, int) {
+	if month == 12 {
+		return year + 1, 1, 1
+	}
+	return year, month + 1, 1
+}
+
+func prevMonth(year, month int) (int, int, int) {
+	if month == 1 {
+		return year - 1, 12, 31
+	}
+	return year, month - 1, daysIn(year, month-1)
+}
+
+func reversed(values []int) []int {
+	for i, j := 0, len(values)-1; i < j; i, j = i+1, j-1 {
+		values[i], values[j] = values[j], values[i]
+	}
+	return values
+}
@@ -0,0 +1,361 @@
+package cron
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSchedule_NextPrev(t *testing.T) {
+	type testCase struct {
+		name                 string
+		inTestDOWStartsAtOne bool
+		inExpr               string
+		inTime               string
+		inLocation           string
+		outNext              string
+		outPrev              string
+	}
+
+	tcs := []testCase{
+		{
+			name:    "should step minutes",
+			inExpr:  "*/15 * * * *",
+			inTime:  "2026-01-01T00:07:30Z",
+			outNext: "2026-01-01T00:15:00Z",
+			outPrev: "2026-01-01T00:00:00Z",
+		}, {
+			name:    "should be exclusive",
+			inExpr:  "*/15 * * * *",
+			inTime:  "2026-01-01T00:15:00Z",
+			outNext: "2026-01-01T00:30:00Z",
+			outPrev: "2026-01-01T00:00:00Z",
+		}, {
+			name:    "should handle seconds",
+			inExpr:  "30 */5 * * * *",
+			inTime:  "2026-01-01T10:04:59Z",
+			outNext: "2026-01-01T10:05:30Z",
+			outPrev: "2026-01-01T10:00:30Z",
+		}, {
+			name:    "should handle year",
+			inExpr:  "0 0 12 * * ? 2030",
+			inTime:  "2026-06-15T00:00:00Z",
+			outNext: "2030-01-01T12:00:00Z",
+			outPrev: "",
+		}, {
+			name:    "should handle year range",
+			inExpr:  "0 0 1 1 * 2020-2022",
+			inTime:  "2026-06-15T00:00:00Z",
+			outNext: "",
+			outPrev: "2022-01-01T00:00:00Z",
+		}, {
+			name:    "should step years from year 1",
+			inExpr:  "0 0 0 1 1 ? */2",
+			inTime:  "2026-06-15T00:00:00Z",
+			outNext: "2027-01-01T00:00:00Z",
+			outPrev: "2025-01-01T00:00:00Z",
+		}, {
+			name:    "should handle last day of month",
+			inExpr:  "0 0 0 L * ?",
+			inTime:  "2026-02-10T00:00:00Z",
+			outNext: "2026-02-28T00:00:00Z",
+			outPrev: "2026-01-31T00:00:00Z",
+		}, {
+			name:    "should handle last weekday of month",
+			inExpr:  "0 0 LW * *",
+			inTime:  "2026-05-01T00:00:00Z",
+			outNext: "2026-05-29T00:00:00Z",
+			outPrev: "2026-04-30T00:00:00Z",
+		}, {
+			name:    "should handle nearest weekday on Saturday",
+			inExpr:  "0 0 15W * *",
+			inTime:  "2026-08-01T00:00:00Z",
+			outNext: "2026-08-14T00:00:00Z",
+			outPrev: "2026-07-15T00:00:00Z",
+		}, {
+			name:    "should not cross month start for nearest weekday",
+			inExpr:  "0 0 1W 8 *",
+			inTime:  "2026-07-01T00:00:00Z",
+			outNext: "2026-08-03T00:00:00Z",
+			outPrev: "2025-08-01T00:00:00Z",
+		}, {
+			name:    "should handle last day offset",
+			inExpr:  "0 0 L-3 * *",
+			inTime:  "2026-02-01T00:00:00Z",
+			outNext: "2026-02-25T00:00:00Z",
+			outPrev: "2026-01-28T00:00:00Z",
+		}, {
+			name:    "should handle nth weekday",
+			inExpr:  "0 0 * * 5#3",
+			inTime:  "2026-01-01T00:00:00Z",
+			outNext: "2026-01-16T00:00:00Z",
+			outPrev: "2025-12-19T00:00:00Z",
+		}, {
+			name:                 "should handle nth weekday when DOW starts at one",
+			inTestDOWStartsAtOne: true,
+			inExpr:               "0 0 12 ? * 6#3",
+			inTime:               "2026-01-01T00:00:00Z",
+			outNext:              "2026-01-16T12:00:00Z",
+			outPrev:              "2025-12-19T12:00:00Z",
+		}, {
+			name:    "should handle last weekday of the month",
+			inExpr:  "0 0 * * 5L",
+			inTime:  "2026-01-01T00:00:00Z",
+			outNext: "2026-01-30T00:00:00Z",
+			outPrev: "2025-12-26T00:00:00Z",
+		}, {
+			name:    "should match either DOM or DOW",
+			inExpr:  "0 0 13 * 5",
+			inTime:  "2026-02-01T00:00:00Z",
+			outNext: "2026-02-06T00:00:00Z",
+			outPrev: "2026-01-30T00:00:00Z",
+		}, {
+			name:    "should match both DOM and DOW when DOM starts with star",
+			inExpr:  "0 0 */2 * 5",
+			inTime:  "2026-02-01T00:00:00Z",
+			outNext: "2026-02-13T00:00:00Z",
+			outPrev: "2026-01-23T00:00:00Z",
+		}, {
+			name:    "should handle Sunday as 7",
+			inExpr:  "0 0 * * 5-7",
+			inTime:  "2026-02-02T00:00:00Z",
+			outNext: "2026-02-06T00:00:00Z",
+			outPrev: "2026-02-01T00:00:00Z",
+		}, {
+			name:    "should handle leap day",
+			inExpr:  "0 0 29 2 *",
+			inTime:  "2026-01-01T00:00:00Z",
+			outNext: "2028-02-29T00:00:00Z",
+			outPrev: "2024-02-29T00:00:00Z",
+		}, {
+			name:    "should never fire on impossible day",
+			inExpr:  "0 0 30 2 *",
+			inTime:  "2026-01-01T00:00:00Z",
+			outNext: "",
+			outPrev: "",
+		}, {
+			name:       "should skip time in DST gap",
+			inExpr:     "30 2 * * *",
+			inTime:     "2026-03-28T12:00:00+01:00",
+			inLocation: "Europe/Berlin",
+			outNext:    "2026-03-30T02:30:00+02:00",
+			outPrev:    "2026-03-28T02:30:00+01:00",
+		},
+	}
+
+	for i, tc := range tcs {
+		exprDesc, err := NewDescriptor(DayOfWeekStartsAtOne(tc.inTestDOWStartsAtOne))
+		if err != nil {
+			t.Fatalf("failed to create expression descriptor: %s", err)
+		}
+		schedule, err := exprDesc.ToSchedule(tc.inExpr)
+		if err != nil {
+			t.Errorf("%d. %s: expected nil, got error: %s", i, tc.name, err)
+			continue
+		}
+
+		loc := time.UTC
+		if tc.inLocation != "" {
+			if loc, err = time.LoadLocation(tc.inLocation); err != nil {
+				t.Fatalf("failed to load location: %s", err)
+			}
+		}
+		from, err := time.Parse(time.RFC3339, tc.inTime)
+		if err != nil {
+			t.Fatalf("failed to parse time: %s", err)
+		}
+		from = from.In(loc)
+
+		if got := formatScheduleTime(schedule.Next(from)); got != tc.outNext {
+			t.Errorf("%d. %s: expected next '%s', got '%s'", i, tc.name, tc.outNext, got)
+		}
+		if got := formatScheduleTime(schedule.Prev(from)); got != tc.outPrev {
+			t.Errorf("%d. %s: expected prev '%s', got '%s'", i, tc.name, tc.outPrev, got)
+		}
+	}
+}
+
+func TestNewSchedule(t *testing.T) {
+	tcs := []struct {
+		name    string
+		inParts []string
+		outErr  error
+	}{
+		{name: "should error on wrong number of parts", inParts: []string{"*", "*", "*"}, outErr: InvalidExprError},
+		{name: "should error on invalid DOM", inParts: []string{"", "*", "*", "l-", "*", "*", ""}, outErr: InvalidExprDayOfMonthError},
+		{name: "should error on invalid DOW", inParts: []string{"", "*", "*", "*", "*", "#", ""}, outErr: InvalidExprDayOfWeekError},
+		{name: "should error on zero step", inParts: []string{"", "*/0", "*", "*", "*", "*", ""}, outErr: InvalidExprMinuteError},
+		{name: "should build", inParts: []string{"", "*", "*", "*", "*", "*", ""}},
+	}
+
+	for i, tc := range tcs {
+		_, err := NewSchedule(tc.inParts)
+		if tc.outErr == nil {
+			if err != nil {
+				t.Errorf("%d. %s: expected nil, got error: %s", i, tc.name, err)
+			}
+			continue
+		}
+		if !errors.Is(err, tc.outErr) {
+			t.Errorf("%d. %s: expected '%v' error, got '%v'", i, tc.name, tc.outErr, err)
+		}
+	}
+}
+
+func TestNewSchedule_Items(t *testing.T) {
+	tcs := []struct {
+		name     string
+		inExpr   string
+		outField FieldType
+		outItems []Item
+	}{
+		{name: "any", inExpr: "* * * * *", outField: FieldMinute, outItems: []Item{Any{}}},
+		{name: "value list", inExpr: "5,10 * * * *", outField: FieldMinute, outItems: []Item{Value{Value: 5}, Value{Value: 10}}},
+		{name: "range", inExpr: "* * * * MON-FRI", outField: FieldDayOfWeek, outItems: []Item{Range{From: 1, To: 5}}},
+		{name: "step", inExpr: "*/5 * * * *", outField: FieldMinute, outItems: []Item{Step{Base: Any{}, Interval: 5}}},
+		{name: "range step", inExpr: "0-20/3 * * * *", outField: FieldMinute, outItems: []Item{Step{Base: Range{From: 0, To: 20}, Interval: 3}}},
+		{name: "value step", inExpr: "* * 3/5 * *", outField: FieldDayOfMonth, outItems: []Item{Step{Base: Value{Value: 3}, Interval: 5}}},
+		{name: "last day", inExpr: "* * 15,L * *", outField: FieldDayOfMonth, outItems: []Item{Value{Value: 15}, Last{}}},
+		{name: "range to last day", inExpr: "* * 20-L * *", outField: FieldDayOfMonth, outItems: []Item{Range{From: 20, ToLast: true}}},
+		{name: "last weekday", inExpr: "* * LW * *", outField: FieldDayOfMonth, outItems: []Item{LastWeekday{}}},
+		{name: "nearest weekday", inExpr: "* * W15 * *", outField: FieldDayOfMonth, outItems: []Item{NearestWeekday{Day: 15}}},
+		{name: "last offset", inExpr: "* * L-5 * *", outField: FieldDayOfMonth, outItems: []Item{LastOffset{Offset: 5}}},
+		{name: "nth weekday", inExpr: "* * * * FRI#3", outField: FieldDayOfWeek, outItems: []Item{NthWeekday{Weekday: 5, N: 3}}},
+		{name: "last weekday of month", inExpr: "* * * * 5L", outField: FieldDayOfWeek, outItems: []Item{Last{Weekday: 5, HasWeekday: true}}},
+		{name: "unspecified year", inExpr: "* * * * *", outField: FieldYear, outItems: nil},
+	}
+
+	exprDesc, err := NewDescriptor()
+	if err != nil {
+		t.Fatalf("failed to create expression descriptor: %s", err)
+	}
+	for i, tc := range tcs {
+		schedule, err := exprDesc.ToSchedule(tc.inExpr)
+		if err != nil {
+			t.Errorf("%d. %s: expected nil, got error: %s", i, tc.name, err)
+			continue
+		}
+		field := schedule.Fields()[tc.outField]
+		if field.Type != tc.outField {
+			t.Errorf("%d. %s: expected field type '%s', got '%s'", i, tc.name, tc.outField, field.Type)
+		}
+		if !reflect.DeepEqual(field.Items, tc.outItems) {
+			t.Errorf("%d. %s: expected items '%v', got '%v'", i, tc.name, tc.outItems, field.Items)
+		}
+	}
+}
+
+func TestSchedule_String(t *testing.T) {
+	tcs := []struct {
+		inOptions []Option
+		inExpr    string
+		outStr    string
+	}{
+		{inExpr: "* * * * *", outStr: "* * * * *"},
+		{inExpr: "30 4 ? * MON-FRI", outStr: "30 4 * * 1-5"},
+		{inExpr: "15 30 4 * * *", outStr: "15 30 4 * * *"},
+		{inExpr: "0 30 4 * * * 2030", outStr: "0 30 4 * * * 2030"},
+		{inExpr: "* * * * * *", outStr: "* * * * * *"},
+		{inExpr: "0 * * * * * *", outStr: "0 * * * * * *"},
+		{inExpr: "*/5 3 l-2 jan,mar 5l", outStr: "*/5 3 L-2 1,3 5L"},
+		{inExpr: "*/5 9 * * *", outStr: "*/5 9 * * *"},
+		{inExpr: "0 9-9/2 * * *", outStr: "0 9-9/2 * * *"},
+		{inExpr: "0 0 1-1 * *", outStr: "0 0 1 * *"},
+		{inExpr: "0-0 0 0 1 * *", outStr: "0 0 1 * *"},
+		{inExpr: "0 20 1-10,20-L * *", outStr: "0 20 1-10,20-L * *"},
+		{inExpr: "0 0 0 lw * 1#2 2000/10", outStr: "0 0 0 LW * 1#2 2000-2099/10"},
+		{inOptions: []Option{DayOfWeekStartsAtOne(true)}, inExpr: "0 9 * * 2", outStr: "0 9 * * 2"},
+		{inOptions: []Option{DayOfWeekStartsAtOne(true)}, inExpr: "0 9 * * 7,1-3/2,6#3,5L", outStr: "0 9 * * 7,1-3/2,6#3,5L"},
+		{inOptions: []Option{SetDialect(DialectQuartz)}, inExpr: "0 0 9 ? * MON-FRI", outStr: "0 0 9 ? * 2-6"},
+		{inOptions: []Option{SetDialect(DialectQuartz)}, inExpr: "0 0 9 1 * ? 2030", outStr: "0 0 9 1 * ? 2030"},
+		{inOptions: []Option{SetDialect(DialectEventBridge)}, inExpr: "cron(0 9 ? * 2 *)", outStr: "0 9 ? * 2 *"},
+		{inOptions: []Option{SetFieldLayout(LayoutSecondsFirst)}, inExpr: "@daily", outStr: "0 0 0 * * *"},
+	}
+
+	for i, tc := range tcs {
+		exprDesc, err := NewDescriptor(tc.inOptions...)
+		if err != nil {
+			t.Fatalf("failed to create expression descriptor: %s", err)
+		}
+		schedule, err := exprDesc.ToSchedule(tc.inExpr)
+		if err != nil {
+			t.Errorf("%d. %s: expected nil, got error: %s", i, tc.inExpr, err)
+			continue
+		}
+		if got := schedule.String(); got != tc.outStr {
+			t.Errorf("%d. %s: expected '%s', got '%s'", i, tc.inExpr, tc.outStr, got)
+			continue
+		}
+
+		reparsed, err := exprDesc.ToSchedule(schedule.String())
+		if err != nil {
+			t.Errorf("%d. %s: expected nil when reparsing, got error: %s", i, tc.inExpr, err)
+			continue
+		}
+		if !reflect.DeepEqual(reparsed, schedule) {
+			t.Errorf("%d. %s: expected reparsed '%v', got '%v'", i, tc.inExpr, schedule, reparsed)
+		}
+	}
+}
+
+func TestExpressionDescriptor_ToInterval(t *testing.T) {
+	tcs := []struct {
+		inOptions []Option
+		inExpr    string
+		out       time.Duration
+		outErr    error
+	}{
+		{inExpr: "@every 1h30m", out: 90 * time.Minute},
+		{inExpr: "rate(5 minutes)", out: 5 * time.Minute},
+		{inExpr: "@reboot", out: 0},
+		{inExpr: "0 9 * * *", outErr: InvalidExprError},
+		{inExpr: "@every 1x", outErr: InvalidExprError},
+		{inOptions: []Option{SetDialect(DialectVixie)}, inExpr: "@every 1h", outErr: InvalidExprError},
+	}
+
+	for i, tc := range tcs {
+		exprDesc, err := NewDescriptor(tc.inOptions...)
+		if err != nil {
+			t.Fatalf("failed to create expression descriptor: %s", err)
+		}
+		every, err := exprDesc.ToInterval(tc.inExpr)
+		if tc.outErr != nil {
+			if !errors.Is(err, tc.outErr) {
+				t.Errorf("%d. %s: expected '%v' error, got '%v'", i, tc.inExpr, tc.outErr, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%d. %s: expected nil, got error '%v'", i, tc.inExpr, err)
+			continue
+		}
+		if every != tc.out {
+			t.Errorf("%d. %s: expected '%s', got '%s'", i, tc.inExpr, tc.out, every)
+		}
+	}
+}
+
+func formatScheduleTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+var _next time.Time
+
+func BenchmarkSchedule_Next(b *testing.B) {
+	b.StopTimer()
+	exprDesc, _ := NewDescriptor()
+	schedule, err := exprDesc.ToSchedule("0/5 1,5,10,15 */2 L JAN-OCT 1-5/2 2000-2050/10")
+	if err != nil {
+		b.Fatalf("expected nil, got error: %s", err)
+	}
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	b.StartTimer()
+
+	for i := 0; i < b.N; i++ {
+		_next = schedule.Next(from)
+	}
+}
@@ -0,0 +1,177 @@
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type (
+	// timeLayout is the parsed layout of the TimeFormat option.
+	timeLayout struct {
+		items             []timeLayoutItem
+		isNoonAndMidnight bool // Use the noon and midnight of the locale for 12:00 and 00:00
+	}
+
+	// timeLayoutItem is a field (i.e. "hh") or a literal text of a time layout.
+	timeLayoutItem struct {
+		field   string
+		literal string
+		group   int // Index of the optional [...] part the item belongs to, starts at 1, 0 if it's not optional
+	}
+
+	timeLayoutToken struct {
+		in    string
+		field string
+	}
+)
+
+var (
+	// patternTokens are the fields of the pattern language, the longest first.
+	patternTokens = []timeLayoutToken{
+		{"HH", "HH"}, {"H", "H"}, {"hh", "hh"}, {"h", "h"}, {"mm", "mm"}, {"m", "m"}, {"ss", "ss"}, {"s", "s"}, {"a", "a"},
+	}
+	// goLayoutTokens are the fields of the Go time layouts (see the time package), the longest first.
+	goLayoutTokens = []timeLayoutToken{
+		{"15", "HH"}, {"03", "hh"}, {"04", "mm"}, {"05", "ss"}, {"3", "h"}, {"4", "m"}, {"5", "s"}, {"PM", "a"}, {"pm", "a"},
+	}
+)
+
+// parseTimeLayout parses the layout of the TimeFormat option: a Go time layout if it has digits (i.e. "3:04 PM"),
+// else a pattern (i.e. "h:mm a").
+func parseTimeLayout(layout string) (*timeLayout, error) {
+	l := &timeLayout{}
+	if strings.HasPrefix(layout, "N|") {
+		l.isNoonAndMidnight = true
+		layout = layout[2:]
+	}
+	isGoLayout := strings.ContainsAny(layout, "0123456789")
+	tokens := patternTokens
+	if isGoLayout {
+		tokens = goLayoutTokens
+	}
+
+	group, groups, hasHour := 0, 0, false
+	for i := 0; i < len(layout); {
+		switch c := layout[i]; {
+		case c == '[':
+			if group > 0 {
+				return nil, fmt.Errorf("nested optional part at offset %d", i)
+			}
+			groups++
+			group = groups
+			i++
+			continue
+		case c == ']':
+			if group == 0 {
+				return nil, fmt.Errorf("unexpected ']' at offset %d", i)
+			}
+			group = 0
+			i++
+			continue
+		case c == '\'' && !isGoLayout:
+			end := strings.IndexByte(layout[i+1:], '\'')
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated quote at offset %d", i)
+			}
+			l.items = append(l.items, timeLayoutItem{literal: layout[i+1 : i+1+end], group: group})
+			i += end + 2
+			continue
+		}
+
+		token, ok := matchTimeLayoutToken(layout[i:], tokens)
+		if ok {
+			hasHour = hasHour || strings.ContainsAny(token.field, "Hh")
+			l.items = append(l.items, timeLayoutItem{field: token.field, group: group})
+			i += len(token.in)
+			continue
+		}
+		if c := layout[i]; !isGoLayout && (c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z') {
+			return nil, fmt.Errorf("unknown pattern letter '%c' at offset %d, quote the literal text", c, i)
+		}
+		l.items = append(l.items, timeLayoutItem{literal: layout[i : i+1], group: group})
+		i++
+	}
+	if group > 0 {
+		return nil, fmt.Errorf("unterminated optional part")
+	}
+	if !hasHour {
+		return nil, fmt.Errorf("no hour in the layout")
+	}
+	return l, nil
+}
+
+func matchTimeLayoutToken(s string, tokens []timeLayoutToken) (timeLayoutToken, bool) {
+	for _, token := range tokens {
+		if strings.HasPrefix(s, token.in) {
+			return token, true
+		}
+	}
+	return timeLayoutToken{}, false
+}
+
+// format returns the time of day in the layout.
+// The optional parts which only have zero minutes and seconds are left out.
+func (l *timeLayout) format(hour, minute, second int, locale Locale) string {
+	if l.isNoonAndMidnight && minute == 0 && second == 0 {
+		if word := locale.GetString(noon); hour == 12 && word != "" {
+			return word
+		}
+		if word := locale.GetString(midnight); hour == 0 && word != "" {
+			return word
+		}
+	}
+
+	nonZeroGroups := make(map[int]bool)
+	for _, item := range l.items {
+		if (strings.Contains(item.field, "m") && minute != 0) || (strings.Contains(item.field, "s") && second != 0) {
+			nonZeroGroups[item.group] = true
+		}
+	}
+
+	hour12 := hour % 12
+	if hour12 == 0 {
+		hour12 = 12
+	}
+	var b strings.Builder
+	for _, item := range l.items {
+		if item.group > 0 && !nonZeroGroups[item.group] {
+			continue
+		}
+		switch item.field {
+		case "HH":
+			b.WriteString(fmt.Sprintf("%02d", hour))
+		case "H":
+			b.WriteString(strconv.Itoa(hour))
+		case "hh":
+			b.WriteString(fmt.Sprintf("%02d", hour12))
+		case "h":
+			b.WriteString(strconv.Itoa(hour12))
+		case "mm":
+			b.WriteString(fmt.Sprintf("%02d", minute))
+		case "m":
+			b.WriteString(strconv.Itoa(minute))
+		case "ss":
+			b.WriteString(fmt.Sprintf("%02d", second))
+		case "s":
+			b.WriteString(strconv.Itoa(second))
+		case "a":
+			b.WriteString(getPeriod(hour, locale))
+		default:
+			b.WriteString(item.literal)
+		}
+	}
+	return b.String()
+}
+
+// formatTime returns the time of day in the layout of the TimeFormat option,
+// or in the 12-hour or 24-hour format (see Use24HourTimeFormat) if there's no layout.
+func (e *ExpressionDescriptor) formatTime(hour, minute, second string, locale Locale) string {
+	if e.timeLayout == nil {
+		return formatTime(hour, minute, second, locale, e.is24HourTimeFormat)
+	}
+	hourInt, _ := strconv.Atoi(hour)
+	minuteInt, _ := strconv.Atoi(minute)
+	secondInt, _ := strconv.Atoi(second)
+	return e.timeLayout.format(hourInt, minuteInt, secondInt, locale)
+}
@@ -0,0 +1,72 @@
+package cron
+
+import (
+	"testing"
+)
+
+func TestParseTimeLayout(t *testing.T) {
+	tcs := []struct {
+		in     string
+		outErr bool
+	}{
+		{in: "3:04 PM"},
+		{in: "15:04:05"},
+		{in: "h[:mm] a"},
+		{in: "N|HH:mm[:ss]"},
+		{in: "H 'Uhr'"},
+		{in: "HH:MM", outErr: true},
+		{in: "h[:mm[:ss]] a", outErr: true},
+		{in: "h:mm] a", outErr: true},
+		{in: "h[:mm a", outErr: true},
+		{in: "H 'Uhr", outErr: true},
+		{in: "mm:ss", outErr: true},
+	}
+	for i, tc := range tcs {
+		if _, err := parseTimeLayout(tc.in); (err != nil) != tc.outErr {
+			t.Errorf("%d. %s: expected error '%v', got '%v'", i, tc.in, tc.outErr, err)
+		}
+	}
+}
+
+func TestExpressionDescriptor_ToDescription_TimeFormat(t *testing.T) {
+	type testCase struct {
+		inLayout string
+		inLocale LocaleType
+		inExpr   string
+		out      string
+	}
+
+	tcs := []testCase{
+		{inLayout: "3:04 PM", inLocale: Locale_en, inExpr: "5 9 * * *", out: "At 9:05 AM"},
+		{inLayout: "15:04", inLocale: Locale_en, inExpr: "5 21 * * *", out: "At 21:05"},
+		{inLayout: "03:04:05 pm", inLocale: Locale_en, inExpr: "30 5 21 * * *", out: "At 09:05:30 PM"},
+		{inLayout: "h[:mm] a", inLocale: Locale_en, inExpr: "0 9 * * *", out: "At 9 AM"},
+		{inLayout: "h[:mm] a", inLocale: Locale_en, inExpr: "30 9 * * *", out: "At 9:30 AM"},
+		{inLayout: "h[:mm] a", inLocale: Locale_en, inExpr: "0 9-17 * * *", out: "Every hour, between 9 AM and 5 PM"},
+		{inLayout: "N|h[:mm] a", inLocale: Locale_en, inExpr: "0 12 * * *", out: "At noon"},
+		{inLayout: "N|h[:mm] a", inLocale: Locale_en, inExpr: "0 0,12 * * *", out: "At midnight and noon"},
+		{inLayout: "N|h[:mm] a", inLocale: Locale_en, inExpr: "30 12 * * *", out: "At 12:30 PM"},
+		{inLayout: "N|H[:mm] 'h'", inLocale: Locale_fr, inExpr: "0 0 * * *", out: "À minuit"},
+		{inLayout: "N|H 'Uhr'", inLocale: Locale_de, inExpr: "0 12 * * *", out: "Um 12 Uhr"},
+		{inLayout: "HH:mm[:ss]", inLocale: Locale_en, inExpr: "0 0 8 * * *", out: "At 08:00"},
+	}
+
+	for i, tc := range tcs {
+		exprDesc, err := NewDescriptor(SetLocales(tc.inLocale), TimeFormat(tc.inLayout))
+		if err != nil {
+			t.Fatalf("failed to create expression descriptor: %s", err)
+		}
+		desc, err := exprDesc.ToDescription(tc.inExpr, tc.inLocale)
+		if err != nil {
+			t.Errorf("%d. %s: expected nil, got error '%v'", i, tc.inExpr, err)
+			continue
+		}
+		if desc != tc.out {
+			t.Errorf("%d. %s (%s): expected '%s', got '%s'", i, tc.inExpr, tc.inLayout, tc.out, desc)
+		}
+	}
+
+	if _, err := NewDescriptor(TimeFormat("HH:MM")); err == nil {
+		t.Errorf("HH:MM: expected invalid time format error, got nil")
+	}
+}
@@ -0,0 +1,146 @@
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// unwrapLocation removes the CRON_TZ= or TZ= prefix (i.e. "CRON_TZ=Asia/Tokyo 0 9 * * *") of the expression,
+// and returns the time zone of the prefix, or nil if the expression has no prefix.
+// The prefix is replaced by spaces, so the offsets of the parts are kept.
+func (p *cronParser) unwrapLocation(expr string) (string, *time.Location, error) {
+	fields, offsets := splitFields(expr)
+	if len(fields) == 0 {
+		return expr, nil, nil
+	}
+
+	prefix := strings.ToUpper(fields[0])
+	var name string
+	switch {
+	case strings.HasPrefix(prefix, "CRON_TZ="):
+		name = fields[0][len("CRON_TZ="):]
+	case strings.HasPrefix(prefix, "TZ="):
+		name = fields[0][len("TZ="):]
+	default:
+		return expr, nil, nil
+	}
+
+	if name == "" {
+		return "", nil, fmt.Errorf("%s must be followed by a time zone, i.e. CRON_TZ=Asia/Tokyo: %w", fields[0], InvalidExprError)
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return "", nil, fmt.Errorf("unknown time zone %s: %w", name, InvalidExprError)
+	}
+	start, end := offsets[0], offsets[0]+len(fields[0])
+	return expr[:start] + strings.Repeat(" ", end-start) + expr[end:], loc, nil
+}
+
+// getZoneDescription describes the time zone of the time of day, i.e. " JST", and if the viewer location
+// is configured, the times of day converted into it, i.e. " JST (01:00 AM Europe/London)".
+// The converted times which fall on another day for the viewer are marked with the day shift, i.e. "08:00 AM +1 day",
+// since the days of the description are the days of the time zone of the expression.
+// The zone is only described when the time of day mentions a time, so "every minute" is left as it is.
+func (e *ExpressionDescriptor) getZoneDescription(exprParts []string, location *time.Location, locale Locale) string {
+	if location == nil || strings.HasPrefix(exprParts[FieldHour], "*") {
+		return ""
+	}
+
+	now := e.now()
+	desc := " " + zoneAbbreviation(location, now)
+	if e.viewerLocation == nil {
+		return desc
+	}
+
+	var times []string
+	for _, t := range getTimesOfDay(exprParts) {
+		hour, _ := strconv.Atoi(t[0])
+		minute, _ := strconv.Atoi(t[1])
+		second, _ := strconv.Atoi(t[2])
+		exprTime := time.Date(now.Year(), now.Month(), now.Day(), hour, minute, second, 0, location)
+		viewerTime := exprTime.In(e.viewerLocation)
+
+		viewerSecond := ""
+		if t[2] != "" {
+			viewerSecond = strconv.Itoa(viewerTime.Second())
+		}
+		times = append(times, e.formatTime(strconv.Itoa(viewerTime.Hour()), strconv.Itoa(viewerTime.Minute()), viewerSecond,
+			locale)+getDayShiftDescription(exprTime, viewerTime, locale))
+	}
+	if len(times) == 0 {
+		return desc
+	}
+
+	name := e.viewerLocation.String()
+	if name == "Local" {
+		name = zoneAbbreviation(e.viewerLocation, now)
+	}
+	return desc + " (" + joinTimes(times, locale) + " " + name + ")"
+}
+
+// getDayShiftDescription describes the days between the date of t and of the viewer time, i.e. " +1 day",
+// or "" if both are on the same date.
+func getDayShiftDescription(t, viewerTime time.Time, locale Locale) string {
+	date := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	viewerDate := time.Date(viewerTime.Year(), viewerTime.Month(), viewerTime.Day(), 0, 0, 0, 0, time.UTC)
+	days := int(viewerDate.Sub(date).Hours() / 24)
+	if days == 0 {
+		return ""
+	}
+
+	shift := strconv.Itoa(days)
+	if days > 0 {
+		shift = "+" + shift
+	}
+	format := locale.GetString(spaceX0Day)
+	if format == "" {
+		format = " %s"
+	}
+	return sprintf(format, shift)
+}
+
+// getTimesOfDay returns the [hour, minute, second] times the time of day description mentions as specific times,
+// i.e. "At 06:30 AM and 02:30 PM", or nil if the time of day is described otherwise.
+func getTimesOfDay(exprParts []string) (times [][3]string) {
+	second, minute, hour := exprParts[FieldSecond], exprParts[FieldMinute], exprParts[FieldHour]
+	switch {
+	case !containsAny(second, specialChars) && !containsAny(minute, specialChars) && !containsAny(hour, specialChars):
+		// Specific time of day (i.e. 10:14:00)
+		return [][3]string{{hour, minute, second}}
+	case second == "" && !containsAny(minute, specialChars) &&
+		strings.Contains(hour, ",") && !strings.ContainsAny(hour, "-/"):
+		// Hours list with single minute (i.e. 30 6,14,16)
+		for _, h := range strings.Split(hour, ",") {
+			times = append(times, [3]string{h, minute, ""})
+		}
+		return times
+	}
+	return nil
+}
+
+// joinTimes joins the formatted times as a list, i.e. "06:30 AM, 02:30 PM and 04:30 PM".
+func joinTimes(times []string, locale Locale) string {
+	desc := ""
+	for i, t := range times {
+		desc += t
+		if i < len(times)-2 {
+			desc += ", "
+		}
+		if i == len(times)-2 {
+			desc += locale.GetString(spaceAnd) + " "
+		}
+	}
+	return desc
+}
+
+// zoneAbbreviation returns the abbreviation of the time zone at t (i.e. JST), or its name if the zone has no
+// abbreviation (i.e. America/Sao_Paulo instead of -03).
+func zoneAbbreviation(location *time.Location, t time.Time) string {
+	name, _ := t.In(location).Zone()
+	if name == "" || name[0] == '+' || name[0] == '-' {
+		return location.String()
+	}
+	return name
+}
@@ -0,0 +1,182 @@
+package cron
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCronParser_Location(t *testing.T) {
+	type testCase struct {
+		name       string
+		inLocation string
+		inExpr     string
+		outExprs   []string
+		outZone    string
+		outErr     error
+	}
+
+	tcs := []testCase{
+		{name: "should have no location without prefix", inExpr: "0 9 * * *", outExprs: []string{"", "0", "9", "*", "*", "*", ""}},
+		{name: "should parse CRON_TZ prefix", inExpr: "CRON_TZ=Asia/Tokyo 0 9 * * *", outExprs: []string{"", "0", "9", "*", "*", "*", ""}, outZone: "Asia/Tokyo"},
+		{name: "should parse TZ prefix", inExpr: "TZ=Europe/London 0 0 9 * * MON", outExprs: []string{"", "0", "9", "*", "*", "1", ""}, outZone: "Europe/London"},
+		{name: "should parse lowercase prefix", inExpr: "  cron_tz=UTC 0 9 * * *", outExprs: []string{"", "0", "9", "*", "*", "*", ""}, outZone: "UTC"},
+		{name: "should parse prefix of macro", inExpr: "CRON_TZ=Asia/Tokyo @daily", outExprs: []string{"", "0", "0", "*", "*", "*", ""}, outZone: "Asia/Tokyo"},
+		{name: "should parse prefix of EventBridge expression", inExpr: "TZ=Asia/Tokyo cron(0 9 ? * MON-FRI *)", outExprs: []string{"", "0", "9", "*", "*", "1-5", "*"}, outZone: "Asia/Tokyo"},
+		{name: "should default to WithLocation", inLocation: "Asia/Tokyo", inExpr: "0 9 * * *", outExprs: []string{"", "0", "9", "*", "*", "*", ""}, outZone: "Asia/Tokyo"},
+		{name: "should prefer prefix to WithLocation", inLocation: "Asia/Tokyo", inExpr: "CRON_TZ=Europe/London 0 9 * * *", outExprs: []string{"", "0", "9", "*", "*", "*", ""}, outZone: "Europe/London"},
+		{name: "should fail on unknown time zone", inExpr: "CRON_TZ=Mars/Olympus 0 9 * * *", outErr: InvalidExprError},
+		{name: "should fail on empty time zone", inExpr: "CRON_TZ= 0 9 * * *", outErr: InvalidExprError},
+		{name: "should fail on prefix only", inExpr: "CRON_TZ=Asia/Tokyo", outErr: InvalidExprError},
+		{name: "should fail on invalid expression after prefix", inExpr: "CRON_TZ=Asia/Tokyo 0 25 * * *", outErr: InvalidExprHourError},
+	}
+
+	for i, tc := range tcs {
+		var opts []Option
+		if tc.inLocation != "" {
+			loc, err := time.LoadLocation(tc.inLocation)
+			if err != nil {
+				t.Fatalf("failed to load location: %s", err)
+			}
+			opts = append(opts, WithLocation(loc))
+		}
+		exprDesc, err := NewDescriptor(opts...)
+		if err != nil {
+			t.Fatalf("failed to create expression descriptor: %s", err)
+		}
+
+		parsed, tokens, err := exprDesc.parser.parse(tc.inExpr)
+		if tc.outErr != nil {
+			if !errors.Is(err, tc.outErr) {
+				t.Errorf("%d. %s: expected '%v' error, got '%v'", i, tc.name, tc.outErr, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%d. %s: expected nil, got error '%v'", i, tc.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(parsed, tc.outExprs) {
+			t.Errorf("%d. %s: expected '%v', got '%v'", i, tc.name, tc.outExprs, parsed)
+		}
+		zone := ""
+		if tokens.location != nil {
+			zone = tokens.location.String()
+		}
+		if zone != tc.outZone {
+			t.Errorf("%d. %s: expected location '%s', got '%s'", i, tc.name, tc.outZone, zone)
+		}
+	}
+}
+
+func TestCronParser_LocationErrorOffset(t *testing.T) {
+	_, err := NewParser().Parse("CRON_TZ=Asia/Tokyo 0 25 * * *")
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) {
+		t.Fatalf("expected *ParseError, got '%v'", err)
+	}
+	if parseErr.Offset != 21 || parseErr.Token != "25" {
+		t.Errorf("expected '25' at offset 21, got '%s' at offset %d", parseErr.Token, parseErr.Offset)
+	}
+}
+
+func TestExpressionDescriptor_ToDescription_Location(t *testing.T) {
+	type testCase struct {
+		name             string
+		inLocation       string
+		inViewerLocation string
+		inLocale         LocaleType
+		in24Hour         bool
+		inNow            time.Time
+		inExpr           string
+		outDesc          string
+	}
+
+	tcs := []testCase{
+		{name: "should not mention unknown zone", inExpr: "0 9 * * *", outDesc: "At 09:00 AM"},
+		{name: "should mention zone of prefix", inExpr: "CRON_TZ=Asia/Tokyo 0 9 * * *", outDesc: "At 09:00 AM JST"},
+		{name: "should mention zone of WithLocation", inLocation: "Asia/Kolkata", inExpr: "30 14 * * MON-FRI", outDesc: "At 02:30 PM IST, Monday through Friday"},
+		{name: "should mention zone of time range", inExpr: "TZ=Asia/Tokyo * 9 * * *", outDesc: "Every minute, between 09:00 AM and 09:59 AM JST"},
+		{name: "should not mention zone without time", inExpr: "CRON_TZ=Asia/Tokyo */5 * * * *", outDesc: "Every 5 minutes"},
+		{name: "should name zone without abbreviation", inExpr: "CRON_TZ=America/Sao_Paulo 0 9 * * *", outDesc: "At 09:00 AM America/Sao_Paulo"},
+		{name: "should convert time to viewer zone", inViewerLocation: "Europe/London", inExpr: "CRON_TZ=Asia/Tokyo 0 9 * * *", outDesc: "At 09:00 AM JST (01:00 AM Europe/London)"},
+		{name: "should convert time to viewer zone in standard time", inViewerLocation: "Europe/London", inNow: time.Date(2026, 12, 1, 12, 0, 0, 0, time.UTC), inExpr: "CRON_TZ=Asia/Tokyo 0 9 1 12 *", outDesc: "At 09:00 AM JST (12:00 AM Europe/London), on day 1 of the month, only in December"},
+		{name: "should convert half-hour offset", inViewerLocation: "Asia/Kolkata", inExpr: "CRON_TZ=UTC 15 30 12 * * *", outDesc: "At 12:30:15 PM UTC (06:00:15 PM Asia/Kolkata)"},
+		{name: "should convert hours list", inViewerLocation: "UTC", inExpr: "CRON_TZ=Asia/Tokyo 30 6,14,16 * * *", outDesc: "At 06:30 AM, 02:30 PM and 04:30 PM JST (09:30 PM -1 day, 05:30 AM and 07:30 AM UTC)"},
+		{name: "should mark next day in viewer zone", inViewerLocation: "Asia/Tokyo", inExpr: "CRON_TZ=UTC 0 23 * * 1", outDesc: "At 11:00 PM UTC (08:00 AM +1 day Asia/Tokyo), only on Monday"},
+		{name: "should mark day shift in other locale", inViewerLocation: "Asia/Tokyo", inLocale: Locale_fr, inExpr: "CRON_TZ=UTC 0 23 * * *", outDesc: "À 11:00 PM UTC (08:00 AM +1 Asia/Tokyo)"},
+		{name: "should convert in 24-hour format", inViewerLocation: "UTC", in24Hour: true, inExpr: "CRON_TZ=Asia/Tokyo 0 9 * * *", outDesc: "At 09:00 JST (00:00 UTC)"},
+		{name: "should not convert time range", inViewerLocation: "UTC", inExpr: "CRON_TZ=Asia/Tokyo * 9 * * *", outDesc: "Every minute, between 09:00 AM and 09:59 AM JST"},
+		{name: "should not convert unknown zone", inViewerLocation: "UTC", inExpr: "0 9 * * *", outDesc: "At 09:00 AM"},
+		{name: "should convert in other locale", inViewerLocation: "UTC", inLocale: Locale_fr, inExpr: "CRON_TZ=Asia/Tokyo 0 9 * * *", outDesc: "À 09:00 AM JST (12:00 AM UTC)"},
+	}
+
+	loadLocation := func(name string) *time.Location {
+		loc, err := time.LoadLocation(name)
+		if err != nil {
+			t.Fatalf("failed to load location: %s", err)
+		}
+		return loc
+	}
+
+	for i, tc := range tcs {
+		opts := []Option{SetLocales(Locale_fr), Use24HourTimeFormat(tc.in24Hour)}
+		if tc.inLocation != "" {
+			opts = append(opts, WithLocation(loadLocation(tc.inLocation)))
+		}
+		if tc.inViewerLocation != "" {
+			opts = append(opts, WithViewerLocation(loadLocation(tc.inViewerLocation)))
+		}
+		exprDesc, err := NewDescriptor(opts...)
+		if err != nil {
+			t.Fatalf("failed to create expression descriptor: %s", err)
+		}
+		if tc.inNow.IsZero() {
+			tc.inNow = time.Date(2026, 7, 1, 12, 0, 0, 0, time.UTC) // Summer time of Europe/London
+		}
+		exprDesc.now = func() time.Time { return tc.inNow }
+		if tc.inLocale == "" {
+			tc.inLocale = Locale_en
+		}
+
+		desc, err := exprDesc.ToDescription(tc.inExpr, tc.inLocale)
+		if err != nil {
+			t.Errorf("%d. %s: expected nil, got error '%v'", i, tc.name, err)
+			continue
+		}
+		if desc != tc.outDesc {
+			t.Errorf("%d. %s: expected '%s', got '%s'", i, tc.name, tc.outDesc, desc)
+		}
+	}
+}
+
+func TestSchedule_Location(t *testing.T) {
+	exprDesc, err := NewDescriptor()
+	if err != nil {
+		t.Fatalf("failed to create expression descriptor: %s", err)
+	}
+	schedule, err := exprDesc.ToSchedule("CRON_TZ=Asia/Tokyo 0 9 * * *")
+	if err != nil {
+		t.Fatalf("expected nil, got error: %s", err)
+	}
+
+	from := time.Date(2026, 7, 1, 12, 0, 0, 0, time.UTC)
+	if got := formatScheduleTime(schedule.Next(from)); got != "2026-07-02T00:00:00Z" {
+		t.Errorf("expected next '2026-07-02T00:00:00Z', got '%s'", got)
+	}
+	if got := formatScheduleTime(schedule.Prev(from)); got != "2026-07-01T00:00:00Z" {
+		t.Errorf("expected prev '2026-07-01T00:00:00Z', got '%s'", got)
+	}
+
+	if got := schedule.String(); got != "CRON_TZ=Asia/Tokyo 0 9 * * *" {
+		t.Errorf("expected 'CRON_TZ=Asia/Tokyo 0 9 * * *', got '%s'", got)
+	}
+	reparsed, err := exprDesc.ToSchedule(schedule.String())
+	if err != nil {
+		t.Fatalf("expected nil, got error: %s", err)
+	}
+	if !reflect.DeepEqual(reparsed, schedule) {
+		t.Errorf("expected '%v', got '%v'", schedule, reparsed)
+	}
+}